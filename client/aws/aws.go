@@ -13,9 +13,12 @@ import (
 )
 
 // Client is the client struct
-type Client struct{}
+type Client struct {
+	// source is the provenance of the offers fetched by the last GetOffer call.
+	source *client.Source
+}
 
-var _ client.Client = (*Client)(nil)
+var _ client.SourceClient = (*Client)(nil)
 
 // NewClient return a client
 func NewClient() *Client {
@@ -24,8 +27,12 @@ func NewClient() *Client {
 
 // pricing is the api message for AWS pricing .json file
 type pricing struct {
-	Product interface{} `json:"products"`
-	Term    interface{} `json:"terms"`
+	// e.g. 20221026224341
+	Version string `json:"version"`
+	// e.g. 2022-10-26T22:43:41Z
+	PublicationDate string      `json:"publicationDate"`
+	Product         interface{} `json:"products"`
+	Term            interface{} `json:"terms"`
 }
 
 // EngineType is the engine type specified in AWS api message.
@@ -134,9 +141,19 @@ func (c *Client) GetOffer() ([]*client.Offer, error) {
 
 	fillInstancePayload(rawEntryList, offerList)
 
+	c.source = &client.Source{
+		URL:             InfoEndPoint,
+		Version:         rawData.Version,
+		PublicationDate: rawData.PublicationDate,
+	}
 	return offerList, nil
 }
 
+// GetSource returns the provenance of the offers fetched by the last GetOffer call, nil if not fetched yet.
+func (c *Client) GetSource() *client.Source {
+	return c.source
+}
+
 // extractOffer extracts the client.offer from the rawData.
 func extractOffer(rawData *pricing) ([]*client.Offer, error) {
 	bytePrice, err := json.Marshal(rawData.Term)
@@ -101,3 +101,20 @@ type Offer struct {
 type Client interface {
 	GetOffer() ([]*Offer, error)
 }
+
+// Source is the provenance of the offers fetched by a client.
+type Source struct {
+	// URL is the endpoint the offers are fetched from, credentials are excluded.
+	URL string
+	// Version and PublicationDate are the version of the offer file, e.g. AWS: 20221026224341, 2022-10-26T22:43:41Z
+	Version         string
+	PublicationDate string
+	// PageCount is the number of pages fetched if the endpoint is paginated, e.g. GCP.
+	PageCount int
+}
+
+// SourceClient is the client that reports the provenance of the offers fetched by the last GetOffer call.
+type SourceClient interface {
+	Client
+	GetSource() *Source
+}
@@ -15,13 +15,20 @@ import (
 // Client is the client struct
 type Client struct {
 	apiKey string
+	// source is the provenance of the offers fetched by the last GetOffer call.
+	source *client.Source
 }
 
-var _ client.Client = (*Client)(nil)
+var _ client.SourceClient = (*Client)(nil)
 
 // NewClient return a client
 func NewClient(apiKey string) *Client {
-	return &Client{apiKey}
+	return &Client{apiKey: apiKey}
+}
+
+// GetSource returns the provenance of the offers fetched by the last GetOffer call, nil if not fetched yet.
+func (c *Client) GetSource() *client.Source {
+	return c.source
 }
 
 const rdsServiceID = "9662-B51E-5089"
@@ -103,11 +110,13 @@ func (c *Client) getPricingWithPageToken(nextPageToken string) (*pricing, error)
 func (c *Client) GetOffer() ([]*client.Offer, error) {
 	var rawOffer []*offer
 	var token string
+	pageCount := 0
 	for {
 		p, err := c.getPricingWithPageToken(token)
 		if err != nil {
 			return nil, err
 		}
+		pageCount++
 		rawOffer = append(rawOffer, p.OfferList...)
 		if p.NextPageToken == "" {
 			break
@@ -200,6 +209,12 @@ func (c *Client) GetOffer() ([]*client.Offer, error) {
 
 		incrID++
 	}
+
+	c.source = &client.Source{
+		// the API key is excluded from the URL.
+		URL:       priceInfoEndpoint,
+		PageCount: pageCount,
+	}
 	return offerList, nil
 }
 
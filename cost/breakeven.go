@@ -0,0 +1,113 @@
+package cost
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/store"
+)
+
+// TermAnalysis is the cost of a term compared to on-demand.
+type TermAnalysis struct {
+	Term     *store.Term `json:"term"`
+	Estimate *Estimate   `json:"estimate"`
+	// SavingUSD and SavingPercent are the saving of the term compared to on-demand over the horizon, negative if more expensive.
+	SavingUSD     float64 `json:"savingUSD"`
+	SavingPercent float64 `json:"savingPercent"`
+	// BreakEvenMonth is the months of running after which the term costs less than on-demand,
+	// nil if the term never beats on-demand with the utilization.
+	BreakEvenMonth *float64 `json:"breakEvenMonth"`
+}
+
+// Analysis is the break-even analysis of the terms of an engine in a region.
+type Analysis struct {
+	DatabaseEngine client.EngineType `json:"databaseEngine"`
+	Horizon        Horizon           `json:"horizon"`
+	Utilization    float64           `json:"utilization"`
+	// OnDemand is the cheapest on-demand term, which the other terms are compared to.
+	OnDemand *TermAnalysis `json:"onDemand"`
+	// TermAnalysisList is all the terms including on-demand ones, ordered by the total cost ascending.
+	TermAnalysisList []*TermAnalysis `json:"termAnalysisList"`
+	// Cheapest is the term with the lowest total cost over the horizon.
+	Cheapest *TermAnalysis `json:"cheapest"`
+}
+
+// Analyze analyzes when the reserved terms of the engine in the region beat on-demand,
+// and which term minimizes the cost over the horizon with the utilization. Archived terms are excluded.
+func Analyze(region *store.Region, engine client.EngineType, horizon Horizon, utilization float64) (*Analysis, error) {
+	var termList []*store.Term
+	var onDemand *store.Term
+	for _, term := range region.TermList {
+		if term.IsArchived() || term.DatabaseEngine != engine {
+			continue
+		}
+		termList = append(termList, term)
+		if term.Type == client.ChargeTypeOnDemand && (onDemand == nil || term.HourlyUSD < onDemand.HourlyUSD) {
+			onDemand = term
+		}
+	}
+	if onDemand == nil {
+		return nil, fmt.Errorf("no on-demand term of %s found in %s", engine, region.Code)
+	}
+	onDemandEstimate, err := Calculate(onDemand, horizon, utilization)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &Analysis{
+		DatabaseEngine: engine,
+		Horizon:        horizon,
+		Utilization:    utilization,
+	}
+	for _, term := range termList {
+		estimate, err := Calculate(term, horizon, utilization)
+		if err != nil {
+			return nil, err
+		}
+		termAnalysis := &TermAnalysis{
+			Term:           term,
+			Estimate:       estimate,
+			SavingUSD:      onDemandEstimate.TotalUSD - estimate.TotalUSD,
+			BreakEvenMonth: getBreakEvenMonth(term, onDemand, utilization),
+		}
+		if onDemandEstimate.TotalUSD > 0 {
+			termAnalysis.SavingPercent = termAnalysis.SavingUSD / onDemandEstimate.TotalUSD * 100
+		}
+		if term == onDemand {
+			analysis.OnDemand = termAnalysis
+		}
+		analysis.TermAnalysisList = append(analysis.TermAnalysisList, termAnalysis)
+	}
+
+	// the terms are in the order of the region, ties are kept in order.
+	sort.SliceStable(analysis.TermAnalysisList, func(i, j int) bool {
+		return analysis.TermAnalysisList[i].Estimate.TotalUSD < analysis.TermAnalysisList[j].Estimate.TotalUSD
+	})
+	analysis.Cheapest = analysis.TermAnalysisList[0]
+	return analysis, nil
+}
+
+// getBreakEvenMonth returns the months after which the cumulative cost of the term is less than on-demand.
+// The reserved term costs commitment + hourly * h in the first lease, and on-demand costs onDemandHourly * utilization * h,
+// so they break even at h = commitment / (onDemandHourly * utilization - hourly).
+// If they do not break even within the first lease, they never do, as each lease starts with the commitment again.
+func getBreakEvenMonth(term, onDemand *store.Term, utilization float64) *float64 {
+	if term.Type == client.ChargeTypeOnDemand {
+		if term.HourlyUSD > onDemand.HourlyUSD {
+			return nil
+		}
+		breakEven := 0.0
+		return &breakEven
+	}
+	hourlySaving := onDemand.HourlyUSD*utilization - term.HourlyUSD
+	if hourlySaving <= 0 {
+		return nil
+	}
+	breakEvenHour := term.CommitmentUSD / hourlySaving
+	if breakEvenHour > float64(term.GetLeaseYear()*YearInHour) {
+		return nil
+	}
+	breakEvenMonth := Horizon(breakEvenHour).Months()
+	return &breakEvenMonth
+}
@@ -0,0 +1,49 @@
+package cost
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Analyze(t *testing.T) {
+	dbInstanceList, err := store.Load("../data/sample.json")
+	require.NoError(t, err)
+	region := dbInstanceList[0].RegionList[0]
+	require.Equal(t, "us-east-1", region.Code)
+
+	analysis, err := Analyze(region, client.EngineTypePostgreSQL, Years(3), 1)
+	require.NoError(t, err)
+	require.Len(t, analysis.TermAnalysisList, 6)
+	require.Equal(t, "AAA.a", analysis.OnDemand.Term.Code)
+	require.Equal(t, 0.0, analysis.OnDemand.SavingUSD)
+	// 3yr All Upfront is the cheapest over 3 years.
+	require.Equal(t, "AAA.g", analysis.Cheapest.Term.Code)
+	require.InDelta(t, 56712.24-25012, analysis.Cheapest.SavingUSD, 1e-6)
+	// it breaks even after 25012 / 2.158 hours.
+	require.InDelta(t, 25012/2.158/MonthInHour, *analysis.Cheapest.BreakEvenMonth, 1e-6)
+	for i := 1; i < len(analysis.TermAnalysisList); i++ {
+		require.LessOrEqual(t, analysis.TermAnalysisList[i-1].Estimate.TotalUSD, analysis.TermAnalysisList[i].Estimate.TotalUSD)
+	}
+
+	// 1yr All Upfront is the cheapest over 1 year.
+	analysis, err = Analyze(region, client.EngineTypePostgreSQL, Years(1), 1)
+	require.NoError(t, err)
+	require.Equal(t, "AAA.e", analysis.Cheapest.Term.Code)
+
+	// on-demand is the cheapest for a low utilization, and the upfront terms never break even.
+	analysis, err = Analyze(region, client.EngineTypePostgreSQL, Years(1), 0.3)
+	require.NoError(t, err)
+	require.Equal(t, "AAA.a", analysis.Cheapest.Term.Code)
+	for _, termAnalysis := range analysis.TermAnalysisList {
+		if termAnalysis.Term.Code == "AAA.e" {
+			require.Nil(t, termAnalysis.BreakEvenMonth)
+			require.Less(t, termAnalysis.SavingUSD, 0.0)
+		}
+	}
+
+	_, err = Analyze(region, "ORACLE", Years(1), 1)
+	require.Error(t, err)
+}
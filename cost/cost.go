@@ -0,0 +1,100 @@
+// Package cost calculates the cost of the pricing terms, which mirrors getPrice in the frontend.
+package cost
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/store"
+)
+
+const (
+	// YearInHour and MonthInHour are shared with store, which amortizes the commitment of the terms with them.
+	YearInHour  = store.YearInHour
+	MonthInHour = store.MonthInHour
+)
+
+// Horizon is the period the cost is calculated over, in hours.
+type Horizon float64
+
+// Months returns the horizon of n months.
+func Months(n float64) Horizon {
+	return Horizon(n * MonthInHour)
+}
+
+// Years returns the horizon of n years.
+func Years(n float64) Horizon {
+	return Horizon(n * YearInHour)
+}
+
+// Months returns the horizon in months.
+func (h Horizon) Months() float64 {
+	return float64(h) / MonthInHour
+}
+
+// Estimate is the cost of a term over a horizon.
+type Estimate struct {
+	Horizon Horizon `json:"horizon"`
+	// Utilization is the ratio of the horizon the instance is running, from 0 to 1.
+	Utilization float64 `json:"utilization"`
+	// LeaseCount is the number of the reserved leases purchased to cover the horizon, 0 for the on-demand terms.
+	LeaseCount int `json:"leaseCount"`
+	// UpfrontUSD is the commitment paid for the leases.
+	UpfrontUSD float64 `json:"upfrontUSD"`
+	// RecurringUSD is the hourly charge over the horizon.
+	RecurringUSD float64 `json:"recurringUSD"`
+	TotalUSD     float64 `json:"totalUSD"`
+	// MonthlyUSD is the total amortized over the months of the horizon.
+	MonthlyUSD float64 `json:"monthlyUSD"`
+}
+
+// Calculate calculates the cost of the term over the horizon with the utilization.
+//   - On-demand terms are only charged for the hours running.
+//   - Reserved terms are charged for every hour of the horizon regardless of the utilization,
+//     and the commitment is paid upfront for each lease started in the horizon.
+//
+// The hourly charge of the reserved lease after the horizon is not counted, the same as the frontend.
+func Calculate(term *store.Term, horizon Horizon, utilization float64) (*Estimate, error) {
+	if horizon < 0 {
+		return nil, fmt.Errorf("horizon should not be negative, got %v", horizon)
+	}
+	if utilization < 0 || utilization > 1 {
+		return nil, fmt.Errorf("utilization should be between 0 and 1, got %v", utilization)
+	}
+
+	estimate := &Estimate{
+		Horizon:     horizon,
+		Utilization: utilization,
+	}
+	switch term.Type {
+	case client.ChargeTypeOnDemand:
+		estimate.RecurringUSD = float64(horizon) * term.HourlyUSD * utilization
+	case client.ChargeTypeReserved:
+		leaseYear := term.GetLeaseYear()
+		if leaseYear == 0 {
+			return nil, fmt.Errorf("unknown lease contract length of the reserved term %s", term.Code)
+		}
+		estimate.LeaseCount = int(math.Ceil(float64(horizon) / float64(leaseYear*YearInHour)))
+		estimate.UpfrontUSD = term.CommitmentUSD * float64(estimate.LeaseCount)
+		estimate.RecurringUSD = float64(horizon) * term.HourlyUSD
+	default:
+		return nil, fmt.Errorf("unknown charge type %q of the term %s", term.Type, term.Code)
+	}
+
+	estimate.TotalUSD = estimate.UpfrontUSD + estimate.RecurringUSD
+	if months := horizon.Months(); months > 0 {
+		estimate.MonthlyUSD = estimate.TotalUSD / months
+	}
+	return estimate, nil
+}
+
+// GetPrice returns the total cost of the term over leaseLength years with the utilization,
+// which is the same as getPrice in the frontend.
+func GetPrice(term *store.Term, utilization float64, leaseLength int) (float64, error) {
+	estimate, err := Calculate(term, Years(float64(leaseLength)), utilization)
+	if err != nil {
+		return 0, err
+	}
+	return estimate.TotalUSD, nil
+}
@@ -0,0 +1,110 @@
+package cost
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+// update regenerates the golden file, e.g. go test ./cost -update
+var update = flag.Bool("update", false, "update the golden file")
+
+const goldenFilePath = "testdata/sample.golden.json"
+
+// goldenCase is the cost of a term of data/sample.json over a horizon.
+type goldenCase struct {
+	ExternalID string    `json:"externalId"`
+	RegionCode string    `json:"regionCode"`
+	TermCode   string    `json:"termCode"`
+	Estimate   *Estimate `json:"estimate"`
+}
+
+func Test_CalculateGolden(t *testing.T) {
+	dbInstanceList, err := store.Load("../data/sample.json")
+	require.NoError(t, err)
+
+	var caseList []*goldenCase
+	for _, dbInstance := range dbInstanceList {
+		for _, region := range dbInstance.RegionList {
+			for _, term := range region.TermList {
+				for _, horizon := range []Horizon{Months(1), Months(18), Years(1), Years(3)} {
+					for _, utilization := range []float64{0.5, 1} {
+						estimate, err := Calculate(term, horizon, utilization)
+						require.NoError(t, err)
+						caseList = append(caseList, &goldenCase{
+							ExternalID: dbInstance.ExternalID,
+							RegionCode: region.Code,
+							TermCode:   term.Code,
+							Estimate:   estimate,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	dataByted, err := json.MarshalIndent(caseList, "", "  ")
+	require.NoError(t, err)
+	if *update {
+		require.NoError(t, os.WriteFile(goldenFilePath, append(dataByted, '\n'), 0644))
+	}
+	golden, err := os.ReadFile(goldenFilePath)
+	require.NoError(t, err)
+	require.JSONEq(t, string(golden), string(dataByted), "run go test ./cost -update to regenerate the golden file")
+}
+
+func Test_GetPrice(t *testing.T) {
+	onDemand := &store.Term{Code: "a", Type: client.ChargeTypeOnDemand, HourlyUSD: 2}
+	reserved1yr := &store.Term{Code: "b", Type: client.ChargeTypeReserved, HourlyUSD: 1, CommitmentUSD: 1000,
+		Payload: &store.TermPayload{LeaseContractLength: "1yr", PurchaseOption: "Partial Upfront"}}
+	reserved3yr := &store.Term{Code: "c", Type: client.ChargeTypeReserved, CommitmentUSD: 30000,
+		Payload: &store.TermPayload{LeaseContractLength: "3yr", PurchaseOption: "All Upfront"}}
+
+	// the expected values are calculated by getPrice in the frontend.
+	tests := []struct {
+		term        *store.Term
+		utilization float64
+		leaseLength int
+		want        float64
+	}{
+		{onDemand, 1, 1, 17520},
+		{onDemand, 0.5, 3, 26280},
+		{reserved1yr, 0.5, 1, 9760},
+		{reserved1yr, 1, 3, 29280},
+		{reserved3yr, 1, 1, 30000},
+		{reserved3yr, 1, 4, 60000},
+		{reserved3yr, 1, 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s/%v/%d", test.term.Code, test.utilization, test.leaseLength), func(t *testing.T) {
+			price, err := GetPrice(test.term, test.utilization, test.leaseLength)
+			require.NoError(t, err)
+			require.InDelta(t, test.want, price, 1e-6)
+		})
+	}
+
+	_, err := GetPrice(onDemand, 1.5, 1)
+	require.Error(t, err)
+	_, err = GetPrice(&store.Term{Type: client.ChargeTypeReserved}, 1, 1)
+	require.Error(t, err)
+}
+
+func Test_Horizon(t *testing.T) {
+	require.Equal(t, Years(1), Months(12))
+	require.Equal(t, 18.0, Months(18).Months())
+
+	// a partial lease is purchased in full.
+	term := &store.Term{Type: client.ChargeTypeReserved, HourlyUSD: 1, CommitmentUSD: 1000,
+		Payload: &store.TermPayload{LeaseContractLength: "1yr"}}
+	estimate, err := Calculate(term, Months(18), 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, estimate.LeaseCount)
+	require.InDelta(t, 2000+18*MonthInHour, estimate.TotalUSD, 1e-6)
+	require.InDelta(t, estimate.TotalUSD/18, estimate.MonthlyUSD, 1e-6)
+}
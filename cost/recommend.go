@@ -0,0 +1,202 @@
+package cost
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+)
+
+// HighAvailabilityFactor is the cost factor of a high available deployment.
+// The dataset only holds the single-zone prices, and both AWS Multi-AZ and GCP regional instances run a standby of the same shape,
+// which is charged the same as the primary.
+const HighAvailabilityFactor = 2
+
+// Requirement is the requirement of the instance to recommend, empty fields match all.
+type Requirement struct {
+	DatabaseEngine client.EngineType `json:"databaseEngine"`
+	MinCPU         int               `json:"minCPU"`
+	// MinMemory is the min memory in GiB.
+	MinMemory         float64  `json:"minMemory"`
+	CloudProviderList []string `json:"cloudProviderList"`
+	// RegionList is the allowed regions, each is either a region code of the provider (e.g. eu-central-1) or a canonical slug (e.g. europe-frankfurt).
+	RegionList    []string           `json:"regionList"`
+	ContinentList []region.Continent `json:"continentList"`
+	// HighAvailability requires a standby in another zone, which is estimated as twice the single-zone price.
+	HighAvailability bool `json:"highAvailability"`
+	// ChargeType is the preferred charge type, empty for the cheapest of both.
+	ChargeType client.ChargeType `json:"chargeType"`
+	Horizon    Horizon           `json:"horizon"`
+	// Utilization is the ratio of the horizon the instance is running, from 0 to 1.
+	Utilization float64 `json:"utilization"`
+	// Limit is the max count of the recommendations, 0 returns all.
+	Limit int `json:"limit"`
+}
+
+// Recommendation is an instance in a region matching the requirement, with the cheapest term of it.
+type Recommendation struct {
+	// Rank starts from 1, the cheapest.
+	Rank       int               `json:"rank"`
+	DBInstance *store.DBInstance `json:"dbInstance"`
+	Region     *store.Region     `json:"region"`
+	Term       *store.Term       `json:"term"`
+	// Estimate is the cost of the deployment, with the standby included if high availability is required.
+	Estimate *Estimate `json:"estimate"`
+	// ReasonList explains why the instance is recommended and ranked, e.g. the cost difference to the top one.
+	ReasonList []string `json:"reasonList"`
+}
+
+// Recommend returns the instances in the regions matching the requirement in the catalog, ranked by the total cost over the horizon.
+// Each instance in a region is recommended once with its cheapest term. Archived instances and terms are excluded.
+// Ties are ranked by the smaller shape, then by the external ID and the region code.
+func Recommend(catalog *store.Catalog, requirement *Requirement) ([]*Recommendation, error) {
+	if requirement.Horizon <= 0 {
+		return nil, fmt.Errorf("horizon should be positive, got %v", requirement.Horizon)
+	}
+	if requirement.Utilization <= 0 || requirement.Utilization > 1 {
+		return nil, fmt.Errorf("utilization should be greater than 0 and no more than 1, got %v", requirement.Utilization)
+	}
+
+	// the entries are indexed by the provider, so the providers are queried one by one.
+	cloudProviderList := requirement.CloudProviderList
+	if len(cloudProviderList) == 0 {
+		cloudProviderList = []string{""}
+	}
+	type key struct {
+		externalID string
+		regionCode string
+	}
+	recommendationMap := make(map[key]*Recommendation)
+	termCountMap := make(map[key]int)
+	for _, cloudProvider := range cloudProviderList {
+		entryList, _ := catalog.Find(&store.Query{
+			CloudProvider:  cloudProvider,
+			DatabaseEngine: requirement.DatabaseEngine,
+			ChargeType:     requirement.ChargeType,
+			MinCPU:         requirement.MinCPU,
+			MinMemory:      requirement.MinMemory,
+		})
+		for _, entry := range entryList {
+			if !requirement.matchRegion(entry.Region) {
+				continue
+			}
+			estimate, err := Calculate(entry.Term, requirement.Horizon, requirement.Utilization)
+			if err != nil {
+				return nil, err
+			}
+			if requirement.HighAvailability {
+				estimate = scaleEstimate(estimate, HighAvailabilityFactor)
+			}
+			k := key{externalID: entry.DBInstance.GetExternalID(), regionCode: entry.Region.Code}
+			termCountMap[k]++
+			if previous, ok := recommendationMap[k]; ok && previous.Estimate.TotalUSD <= estimate.TotalUSD {
+				continue
+			}
+			recommendationMap[k] = &Recommendation{
+				DBInstance: entry.DBInstance,
+				Region:     entry.Region,
+				Term:       entry.Term,
+				Estimate:   estimate,
+			}
+		}
+	}
+
+	var recommendationList []*Recommendation
+	for k, recommendation := range recommendationMap {
+		recommendation.ReasonList = requirement.getReasonList(recommendation, termCountMap[k])
+		recommendationList = append(recommendationList, recommendation)
+	}
+	sort.Slice(recommendationList, func(i, j int) bool {
+		a, b := recommendationList[i], recommendationList[j]
+		if a.Estimate.TotalUSD != b.Estimate.TotalUSD {
+			return a.Estimate.TotalUSD < b.Estimate.TotalUSD
+		}
+		if a.DBInstance.CPU != b.DBInstance.CPU {
+			return a.DBInstance.CPU < b.DBInstance.CPU
+		}
+		if a.DBInstance.GetMemoryGiB() != b.DBInstance.GetMemoryGiB() {
+			return a.DBInstance.GetMemoryGiB() < b.DBInstance.GetMemoryGiB()
+		}
+		if a.DBInstance.GetExternalID() != b.DBInstance.GetExternalID() {
+			return a.DBInstance.GetExternalID() < b.DBInstance.GetExternalID()
+		}
+		return a.Region.Code < b.Region.Code
+	})
+	if requirement.Limit > 0 && requirement.Limit < len(recommendationList) {
+		recommendationList = recommendationList[:requirement.Limit]
+	}
+
+	for i, recommendation := range recommendationList {
+		recommendation.Rank = i + 1
+		if i == 0 {
+			recommendation.ReasonList = append(recommendation.ReasonList, "ranked #1 as the cheapest matching option")
+			continue
+		}
+		top := recommendationList[0].Estimate.TotalUSD
+		diff := recommendation.Estimate.TotalUSD - top
+		reason := fmt.Sprintf("ranked #%d, costing $%.2f more than #1", recommendation.Rank, diff)
+		if top > 0 {
+			reason += fmt.Sprintf(" (+%.2f%%)", diff/top*100)
+		}
+		recommendation.ReasonList = append(recommendation.ReasonList, reason)
+	}
+	return recommendationList, nil
+}
+
+// matchRegion returns true if the region is allowed by both the region list and the continent list.
+func (r *Requirement) matchRegion(region *store.Region) bool {
+	if len(r.RegionList) > 0 {
+		matched := false
+		for _, allowed := range r.RegionList {
+			if strings.EqualFold(allowed, region.Code) || (region.Slug != "" && strings.EqualFold(allowed, region.Slug)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.ContinentList) > 0 {
+		for _, continent := range r.ContinentList {
+			if continent == region.Continent {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// getReasonList explains how the recommendation matches the requirement and how its cost is calculated.
+func (r *Requirement) getReasonList(recommendation *Recommendation, termCount int) []string {
+	dbInstance, region, term, estimate := recommendation.DBInstance, recommendation.Region, recommendation.Term, recommendation.Estimate
+	regionName := region.Code
+	if region.Name != "" {
+		regionName = fmt.Sprintf("%s (%s)", region.Name, region.Code)
+	}
+
+	reasonList := []string{
+		fmt.Sprintf("%d vCPU and %s GiB memory meet the min %d vCPU and %v GiB", dbInstance.CPU, dbInstance.Memory, r.MinCPU, r.MinMemory),
+		fmt.Sprintf("provided by %s in %s", dbInstance.CloudProvider, regionName),
+	}
+	reasonList = append(reasonList, fmt.Sprintf("%s is the cheapest of %d matching terms, costing $%.2f over %.0f months ($%.2f/month)",
+		term.GetName(), termCount, estimate.TotalUSD, r.Horizon.Months(), estimate.MonthlyUSD))
+	if r.HighAvailability {
+		reasonList = append(reasonList, "high availability is estimated as twice the single-zone price for the standby")
+	}
+	return reasonList
+}
+
+// scaleEstimate returns a copy of the estimate with the costs multiplied by factor.
+func scaleEstimate(estimate *Estimate, factor float64) *Estimate {
+	scaled := *estimate
+	scaled.UpfrontUSD *= factor
+	scaled.RecurringUSD *= factor
+	scaled.TotalUSD *= factor
+	scaled.MonthlyUSD *= factor
+	return &scaled
+}
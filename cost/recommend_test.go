@@ -0,0 +1,87 @@
+package cost
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Recommend(t *testing.T) {
+	catalog, err := store.LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+
+	requirement := &Requirement{
+		DatabaseEngine: client.EngineTypePostgreSQL,
+		MinCPU:         8,
+		MinMemory:      32,
+		Horizon:        Years(3),
+		Utilization:    1,
+	}
+	recommendationList, err := Recommend(catalog, requirement)
+	require.NoError(t, err)
+	// the GCP instance only provides MySQL in the sample.
+	require.Len(t, recommendationList, 2)
+	for i, recommendation := range recommendationList {
+		require.Equal(t, i+1, recommendation.Rank)
+		require.Equal(t, "AWS:db.r6g.4xlarge", recommendation.DBInstance.GetExternalID())
+		require.NotEmpty(t, recommendation.ReasonList)
+	}
+	require.LessOrEqual(t, recommendationList[0].Estimate.TotalUSD, recommendationList[1].Estimate.TotalUSD)
+
+	// the 3yr All Upfront term is the cheapest in us-east-1.
+	requirement.RegionList = []string{"us-east-n-virginia"}
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.Len(t, recommendationList, 1)
+	require.Equal(t, "AAA.g", recommendationList[0].Term.Code)
+	require.InDelta(t, 25012, recommendationList[0].Estimate.TotalUSD, 1e-6)
+
+	// high availability doubles the cost.
+	requirement.HighAvailability = true
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.InDelta(t, 25012*2, recommendationList[0].Estimate.TotalUSD, 1e-6)
+
+	// the on-demand preference only ranks the on-demand terms.
+	requirement.HighAvailability = false
+	requirement.ChargeType = client.ChargeTypeOnDemand
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.Equal(t, "AAA.a", recommendationList[0].Term.Code)
+
+	requirement = &Requirement{
+		DatabaseEngine: client.EngineTypeMySQL,
+		ContinentList:  []region.Continent{region.ContinentAsia},
+		Horizon:        Months(12),
+		Utilization:    1,
+	}
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.Len(t, recommendationList, 1)
+	require.Equal(t, "ap-south-1", recommendationList[0].Region.Code)
+
+	requirement = &Requirement{
+		DatabaseEngine:    client.EngineTypeMySQL,
+		CloudProviderList: []string{"AWS", "GCP"},
+		Horizon:           Months(12),
+		Utilization:       1,
+		Limit:             2,
+	}
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.Len(t, recommendationList, 2)
+
+	requirement.CloudProviderList = []string{"GCP"}
+	requirement.MinCPU = 64
+	recommendationList, err = Recommend(catalog, requirement)
+	require.NoError(t, err)
+	require.Len(t, recommendationList, 1)
+	require.Equal(t, "GCP:db-N1Standard-96-360", recommendationList[0].DBInstance.GetExternalID())
+
+	requirement.Utilization = 0
+	_, err = Recommend(catalog, requirement)
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+func writeCSV(w io.Writer, rowList []*Row) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columnList); err != nil {
+		return err
+	}
+	record := make([]string, len(columnList))
+	for _, row := range rowList {
+		for i, value := range row.values() {
+			switch v := value.(type) {
+			case float64:
+				// the shortest representation keeps the prices as is, e.g. 0.0035 rather than 0.003500.
+				record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
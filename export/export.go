@@ -0,0 +1,149 @@
+// Package export flattens the pricing catalog into one row per price point for spreadsheets and BI tools.
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+// Format is the file format of the export.
+type Format string
+
+const (
+	// FormatCSV is the comma separated values with a header row.
+	FormatCSV Format = "csv"
+	// FormatParquet is the Apache Parquet columnar format.
+	FormatParquet Format = "parquet"
+	// FormatXLSX is the Excel workbook with a single sheet.
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormatList parses the comma separated format list, e.g. csv,xlsx.
+func ParseFormatList(s string) ([]Format, error) {
+	var formatList []Format
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		switch format := Format(item); format {
+		case FormatCSV, FormatParquet, FormatXLSX:
+			formatList = append(formatList, format)
+		default:
+			return nil, fmt.Errorf("unknown export format %q, allowed formats are csv, parquet and xlsx", item)
+		}
+	}
+	return formatList, nil
+}
+
+// Row is a price point, that is a term of an instance in a region, with all the dimensions flattened.
+type Row struct {
+	CloudProvider string
+	ExternalID    string
+	InstanceName  string
+	CPU           int
+	MemoryGiB     float64
+	Processor     string
+	Family        string
+
+	RegionCode string
+	RegionSlug string
+	RegionName string
+
+	TermCode       string
+	DatabaseEngine string
+	ChargeType     string
+	// LeaseContractLength and PurchaseOption are empty for the on-demand terms.
+	LeaseContractLength string
+	PurchaseOption      string
+
+	HourlyUSD  float64
+	UpfrontUSD float64
+	// EffectiveMonthlyUSD is the monthly cost with the upfront amortized over the lease contract length.
+	EffectiveMonthlyUSD float64
+}
+
+// columnList is the header of the tabular formats, in the order of Row.values.
+var columnList = []string{
+	"cloud_provider", "external_id", "instance_name", "cpu", "memory_gib", "processor", "family",
+	"region_code", "region_slug", "region_name",
+	"term_code", "database_engine", "charge_type", "lease_contract_length", "purchase_option",
+	"hourly_usd", "upfront_usd", "effective_monthly_usd",
+}
+
+func (r *Row) values() []interface{} {
+	return []interface{}{
+		r.CloudProvider, r.ExternalID, r.InstanceName, r.CPU, r.MemoryGiB, r.Processor, r.Family,
+		r.RegionCode, r.RegionSlug, r.RegionName,
+		r.TermCode, r.DatabaseEngine, r.ChargeType, r.LeaseContractLength, r.PurchaseOption,
+		r.HourlyUSD, r.UpfrontUSD, r.EffectiveMonthlyUSD,
+	}
+}
+
+// Flatten flattens the dbInstanceList into rows in the order of instances, regions and terms.
+// The instances and terms no longer provided upstream are excluded.
+func Flatten(dbInstanceList []*store.DBInstance) []*Row {
+	var rowList []*Row
+	for _, dbInstance := range dbInstanceList {
+		if dbInstance.IsArchived() {
+			continue
+		}
+		for _, region := range dbInstance.RegionList {
+			for _, term := range region.TermList {
+				if term.IsArchived() {
+					continue
+				}
+				row := &Row{
+					CloudProvider:       dbInstance.CloudProvider,
+					ExternalID:          dbInstance.GetExternalID(),
+					InstanceName:        dbInstance.Name,
+					CPU:                 dbInstance.CPU,
+					MemoryGiB:           dbInstance.GetMemoryGiB(),
+					Processor:           dbInstance.Processor,
+					Family:              string(dbInstance.Family),
+					RegionCode:          region.Code,
+					RegionSlug:          region.Slug,
+					RegionName:          region.Name,
+					TermCode:            term.Code,
+					DatabaseEngine:      string(term.DatabaseEngine),
+					ChargeType:          string(term.Type),
+					HourlyUSD:           term.HourlyUSD,
+					UpfrontUSD:          term.CommitmentUSD,
+					EffectiveMonthlyUSD: term.GetEffectiveHourlyUSD() * store.MonthInHour,
+				}
+				if term.Payload != nil {
+					row.LeaseContractLength = term.Payload.LeaseContractLength
+					row.PurchaseOption = term.Payload.PurchaseOption
+				}
+				rowList = append(rowList, row)
+			}
+		}
+	}
+	return rowList
+}
+
+// Write writes the rows to w in the format.
+func Write(w io.Writer, rowList []*Row, format Format) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, rowList)
+	case FormatParquet:
+		return writeParquet(w, rowList)
+	case FormatXLSX:
+		return writeXLSX(w, rowList)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+// Save saves the rows to filePath in the format atomically.
+func Save(rowList []*Row, filePath string, format Format) error {
+	var buf bytes.Buffer
+	if err := Write(&buf, rowList, format); err != nil {
+		return err
+	}
+	return store.WriteFileAtomic(filePath, buf.Bytes())
+}
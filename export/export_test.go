@@ -0,0 +1,78 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func Test_Flatten(t *testing.T) {
+	dbInstanceList, err := store.Load("../data/sample.json")
+	require.NoError(t, err)
+
+	rowList := Flatten(dbInstanceList)
+	require.Len(t, rowList, 25)
+	require.Equal(t, &Row{
+		CloudProvider:       "AWS",
+		ExternalID:          "AWS:db.r6g.4xlarge",
+		InstanceName:        "db.r6g.4xlarge",
+		CPU:                 16,
+		MemoryGiB:           128,
+		Processor:           "AWS Graviton2",
+		Family:              "MEMORY_OPTIMIZED",
+		RegionCode:          "us-east-1",
+		RegionSlug:          "us-east-n-virginia",
+		RegionName:          "US East (N. Virginia)",
+		TermCode:            "AAA.a",
+		DatabaseEngine:      "POSTGRES",
+		ChargeType:          "OnDemand",
+		HourlyUSD:           2.158,
+		EffectiveMonthlyUSD: 2.158 * 730,
+	}, rowList[0])
+
+	// the upfront is amortized over the lease contract length.
+	reserved := rowList[2]
+	require.Equal(t, "3yr", reserved.LeaseContractLength)
+	require.Equal(t, "Partial Upfront", reserved.PurchaseOption)
+	require.InDelta(t, 0.4856*730+12761.0/36, reserved.EffectiveMonthlyUSD, 1e-6)
+
+	// archived terms are excluded.
+	dbInstanceList[1].RowStatus = store.RowStatusArchived
+	require.Len(t, Flatten(dbInstanceList), 24)
+}
+
+func Test_Write(t *testing.T) {
+	dbInstanceList, err := store.Load("../data/sample.json")
+	require.NoError(t, err)
+	rowList := Flatten(dbInstanceList)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, rowList, FormatCSV))
+	recordList, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, recordList, len(rowList)+1)
+	require.Equal(t, columnList, recordList[0])
+	require.Equal(t, "2.158", recordList[1][15])
+
+	buf.Reset()
+	require.NoError(t, Write(&buf, rowList, FormatXLSX))
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	xlsxRowList, err := f.GetRows(sheetName)
+	require.NoError(t, err)
+	require.Len(t, xlsxRowList, len(rowList)+1)
+	require.Equal(t, "AWS:db.r6g.4xlarge", xlsxRowList[1][1])
+
+	buf.Reset()
+	require.NoError(t, Write(&buf, rowList, FormatParquet))
+	// a parquet file starts and ends with the magic number.
+	require.Equal(t, "PAR1", string(buf.Bytes()[:4]))
+	require.Equal(t, "PAR1", string(buf.Bytes()[buf.Len()-4:]))
+
+	_, err = ParseFormatList("csv,json")
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"io"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRow is the Row with the parquet schema, the column names are the same as the header of the other formats.
+type parquetRow struct {
+	CloudProvider string  `parquet:"name=cloud_provider, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	ExternalID    string  `parquet:"name=external_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	InstanceName  string  `parquet:"name=instance_name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	CPU           int64   `parquet:"name=cpu, type=INT64"`
+	MemoryGiB     float64 `parquet:"name=memory_gib, type=DOUBLE"`
+	Processor     string  `parquet:"name=processor, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Family        string  `parquet:"name=family, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+
+	RegionCode string `parquet:"name=region_code, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	RegionSlug string `parquet:"name=region_slug, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	RegionName string `parquet:"name=region_name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+
+	TermCode            string `parquet:"name=term_code, type=BYTE_ARRAY, convertedtype=UTF8"`
+	DatabaseEngine      string `parquet:"name=database_engine, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	ChargeType          string `parquet:"name=charge_type, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	LeaseContractLength string `parquet:"name=lease_contract_length, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	PurchaseOption      string `parquet:"name=purchase_option, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+
+	HourlyUSD           float64 `parquet:"name=hourly_usd, type=DOUBLE"`
+	UpfrontUSD          float64 `parquet:"name=upfront_usd, type=DOUBLE"`
+	EffectiveMonthlyUSD float64 `parquet:"name=effective_monthly_usd, type=DOUBLE"`
+}
+
+func writeParquet(w io.Writer, rowList []*Row) error {
+	// the rows are written in a single goroutine, the output is small enough.
+	pw, err := writer.NewParquetWriterFromWriter(w, new(parquetRow), 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	for _, row := range rowList {
+		if err := pw.Write(&parquetRow{
+			CloudProvider:       row.CloudProvider,
+			ExternalID:          row.ExternalID,
+			InstanceName:        row.InstanceName,
+			CPU:                 int64(row.CPU),
+			MemoryGiB:           row.MemoryGiB,
+			Processor:           row.Processor,
+			Family:              row.Family,
+			RegionCode:          row.RegionCode,
+			RegionSlug:          row.RegionSlug,
+			RegionName:          row.RegionName,
+			TermCode:            row.TermCode,
+			DatabaseEngine:      row.DatabaseEngine,
+			ChargeType:          row.ChargeType,
+			LeaseContractLength: row.LeaseContractLength,
+			PurchaseOption:      row.PurchaseOption,
+			HourlyUSD:           row.HourlyUSD,
+			UpfrontUSD:          row.UpfrontUSD,
+			EffectiveMonthlyUSD: row.EffectiveMonthlyUSD,
+		}); err != nil {
+			return err
+		}
+	}
+	return pw.WriteStop()
+}
@@ -1,11 +1,10 @@
 import { useState } from "react";
 import { Select, Button } from "antd";
 import { useRouter } from "next/router";
-import { nameToSlug } from "utils";
-import { DBInstance } from "@/types";
+import { getDBInstanceList, nameToSlug } from "utils";
 import data from "@data";
 
-const instanceTypeList = (data as DBInstance[]).map((dbInstance, index) => ({
+const instanceTypeList = getDBInstanceList(data).map((dbInstance, index) => ({
   label: dbInstance.name,
   value: index,
 }));
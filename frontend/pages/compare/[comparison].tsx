@@ -8,7 +8,12 @@ import CompareTable from "@/components/CompareTable";
 import RegionPricingTable from "@/components/RegionPricingTable";
 import LineChart from "@/components/LineChart";
 import { useDBInstanceContext, useSearchConfigContext } from "@/stores";
-import { getPrice, getRegionName, slugToName } from "@/utils";
+import {
+  getDBInstanceList,
+  getPrice,
+  getRegionName,
+  slugToName,
+} from "@/utils";
 import {
   DataSource,
   PageType,
@@ -310,7 +315,7 @@ const InstanceComparison: NextPage<Props> = ({
 export default InstanceComparison;
 
 export const getStaticPaths: GetStaticPaths = async () => {
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
   const comparisonList = [];
   // Traverse and generate all possible comparison pairs.
   for (let i = 0; i < data.length - 1; i++) {
@@ -329,7 +334,7 @@ export const getStaticPaths: GetStaticPaths = async () => {
 
 export const getStaticProps: GetStaticProps = async (context) => {
   const { comparison } = context.params as unknown as Params;
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
   const [comparerA, comparerB] = slugToName(comparison);
 
   const getRegionPricing = (name: string): RegionPricingType[] => {
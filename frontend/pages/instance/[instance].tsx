@@ -8,10 +8,9 @@ import RelatedTable from "@/components/RelatedTable";
 import LineChart from "@/components/LineChart";
 import { useDBInstanceContext, useSearchConfigContext } from "@/stores";
 import {
+  getDBInstanceList,
   getPrice,
   getRegionName,
-  getInstanceFamily,
-  getInstanceSize,
 } from "@/utils";
 import {
   CloudProvider,
@@ -32,6 +31,9 @@ interface Props {
   basicInfo: {
     name: string;
     provider: CloudProvider;
+    // series and size are classified by the seeder, e.g. r6g and xlarge of db.r6g.xlarge.
+    series: string;
+    size: string;
     CPU: number;
     memory: number;
     regionCount: number;
@@ -186,7 +188,7 @@ const InstanceDetail: NextPage<Props> = ({
   sameFamilyList,
   sameSizeList,
 }) => {
-  const { name, provider, CPU, memory } = basicInfo;
+  const { name, provider, series, size, CPU, memory } = basicInfo;
 
   const [dataSource, setDataSource] = useState<DataSource[]>(
     serverSideCompareTableData
@@ -249,7 +251,7 @@ const InstanceDetail: NextPage<Props> = ({
             title={
               <>
                 Instances in the{" "}
-                <i>{getInstanceFamily(name, provider, true)}</i> family
+                <i>{series}</i> family
               </>
             }
             instance={name}
@@ -259,7 +261,7 @@ const InstanceDetail: NextPage<Props> = ({
             <RelatedTable
               title={
                 <>
-                  Instances of the <i>{getInstanceSize(name, provider)}</i> size
+                  Instances of the <i>{size}</i> size
                 </>
               }
               instance={name}
@@ -275,7 +277,7 @@ const InstanceDetail: NextPage<Props> = ({
 export default InstanceDetail;
 
 export const getStaticPaths: GetStaticPaths = async () => {
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
 
   return {
     paths: data.map((instance) => ({
@@ -287,7 +289,7 @@ export const getStaticPaths: GetStaticPaths = async () => {
 
 export const getStaticProps: GetStaticProps = async (context) => {
   const { instance: instanceName } = context.params as unknown as Params;
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
   const instanceData = data.find((instance) => instance.name === instanceName);
 
   const serverSideCompareTableData = generateTableData(
@@ -315,15 +317,22 @@ export const getStaticProps: GetStaticProps = async (context) => {
   );
 
   const getSameFamilyList = (): RelatedType[] => {
-    const currClass = getInstanceFamily(
-      instanceName,
-      instanceData?.cloudProvider as CloudProvider
-    );
-    if (!currClass) {
+    if (!instanceData?.series) {
       return [];
     }
     return data
-      .filter((instance) => instance.name.startsWith(currClass))
+      .filter(
+        (instance) =>
+          instance.cloudProvider === instanceData.cloudProvider &&
+          instance.series === instanceData.series
+      )
+      // Sort ascend by the size rank, which is proportional to the capacity, then CPU and memory.
+      .sort(
+        (a, b) =>
+          a.sizeRank - b.sizeRank ||
+          a.cpu - b.cpu ||
+          Number(a.memory) - Number(b.memory)
+      )
       .map((instance) => {
         let virginiaTermHourlyUSD: number | null = null;
         const virginia = instance.regionList.find((region) =>
@@ -345,27 +354,19 @@ export const getStaticProps: GetStaticProps = async (context) => {
           memory: Number(instance.memory),
           hourlyUSD: virginiaTermHourlyUSD,
         };
-      })
-      .sort((a, b) => {
-        // Sort ascend by CPU first, then memory.
-        const difference = a.CPU - b.CPU;
-        if (difference !== 0) {
-          return difference;
-        }
-        return a.memory - b.memory;
       });
   };
 
   const getSameSizeList = (): RelatedType[] => {
-    const currSize = getInstanceSize(
-      instanceName,
-      instanceData?.cloudProvider as CloudProvider
-    );
-    if (!currSize) {
+    if (!instanceData?.size) {
       return [];
     }
     return data
-      .filter((instance) => instance.name.endsWith(`.${currSize}`))
+      .filter(
+        (instance) =>
+          instance.cloudProvider === instanceData.cloudProvider &&
+          instance.size === instanceData.size
+      )
       .map((instance) => {
         let virginiaTermHourlyUSD: number | null = null;
         const virginiaTerm = instance.regionList.find((region) =>
@@ -402,6 +403,8 @@ export const getStaticProps: GetStaticProps = async (context) => {
       basicInfo: {
         name: instanceName,
         provider: instanceData?.cloudProvider,
+        series: instanceData?.series ?? "",
+        size: instanceData?.size ?? "",
         CPU: instanceData?.cpu,
         memory: instanceData?.memory.trim(),
         regionCount: instanceData?.regionList.length,
@@ -6,7 +6,12 @@ import RegionMenu from "@/components/RegionMenu";
 import SearchMenu from "@/components/SearchMenu";
 import CompareTable from "@/components/CompareTable";
 import { useDBInstanceContext, useSearchConfigContext } from "@/stores";
-import { getPrice, getRegionCode, getRegionName } from "@/utils";
+import {
+  getDBInstanceList,
+  getPrice,
+  getRegionCode,
+  getRegionName,
+} from "@/utils";
 import {
   DataSource,
   CloudProvider,
@@ -247,7 +252,7 @@ export const getStaticPaths = () => ({
 
 export const getStaticProps: GetStaticProps = async (context) => {
   const { provider: providerName } = context.params as unknown as Params;
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
   // For SEO, showing the first page is enough. So we only need to
   // pass the first page of data to the page. Passing the whole large
   // `data` will make this page twice as large and reduce performance.
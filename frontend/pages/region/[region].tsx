@@ -10,6 +10,7 @@ import CompareTable from "@/components/CompareTable";
 import LineChart from "@/components/LineChart";
 import { useDBInstanceContext, useSearchConfigContext } from "@/stores";
 import {
+  getDBInstanceList,
   getPrice,
   getRegionName,
   getRegionPrefix,
@@ -275,7 +276,7 @@ const Region: NextPage<Props> = ({
 export default Region;
 
 export const getStaticPaths: GetStaticPaths = async () => {
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
 
   const usedRegionCodeSet = new Set<string>();
   data.forEach((instance) => {
@@ -299,7 +300,7 @@ export const getStaticPaths: GetStaticPaths = async () => {
 
 export const getStaticProps: GetStaticProps = async (context) => {
   const { region: regionSlug } = context.params as unknown as Params;
-  const data = (await import("@data")).default as DBInstance[];
+  const data = getDBInstanceList((await import("@data")).default);
 
   // Only pass the first page of data on SSG to reduce page size.
   const firstPageData = data.slice(0, tablePaginationConfig.defaultPageSize);
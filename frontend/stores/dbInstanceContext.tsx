@@ -5,7 +5,7 @@ import {
   useMemo,
   useCallback,
 } from "react";
-import { getRegionName } from "@/utils";
+import { getDBInstanceList, getRegionName } from "@/utils";
 import { AvailableRegion, DBInstance, DBInstanceId, Region } from "@/types";
 
 const DBInstanceContext = createContext<ContextStore>({
@@ -98,7 +98,7 @@ export const DBInstanceContextProvider: React.FC<ProviderProps> = ({
   }, [dbInstanceList]);
 
   const loadDBInstanceList = useCallback(async () => {
-    const data = (await import("@data")).default as DBInstance[];
+    const data = getDBInstanceList((await import("@data")).default);
     setDBInstanceList(data);
   }, []);
 
@@ -7,7 +7,20 @@ import {
 } from "./common";
 import { Region } from "./region";
 
-export type InstanceFamily = "GENERAL" | "MEMORY";
+export type InstanceFamily =
+  | "GENERAL_PURPOSE"
+  | "MEMORY_OPTIMIZED"
+  | "BURSTABLE"
+  | "COMPUTE_OPTIMIZED";
+
+export type Architecture = "X86_64" | "ARM64";
+
+// Equivalent is an instance on another provider similar to the instance.
+export type Equivalent = {
+  externalId: ExternalId;
+  // score is the similarity from 0 to 1.
+  score: number;
+};
 
 export type DBInstance = {
   id: DBInstanceId;
@@ -16,6 +29,7 @@ export type DBInstance = {
   creatorId: ContributorId;
   updaterId: ContributorId;
   updatedTs: number;
+  archivedTs: number;
 
   regionList: Region[];
 
@@ -24,4 +38,64 @@ export type DBInstance = {
   cpu: number;
   memory: string;
   processor: string;
+
+  // taxonomy fields, shared by all providers.
+  family: InstanceFamily;
+  series: string;
+  size: string;
+  sizeRank: number;
+  architecture: Architecture;
+
+  // the nearest equivalents on the other providers, ordered by the score.
+  equivalentList: Equivalent[] | null;
+};
+
+export type Source = {
+  cloudProvider: CloudProvider;
+  url: string;
+  version?: string;
+  publicationDate?: string;
+  pageCount?: number;
+  fetchedTs: number;
+  offerCount: number;
+  instanceCount: number;
+};
+
+export type Metadata = {
+  generatedTs: number;
+  version: string;
+  sourceList: Source[];
+  overrideCount: number;
+  instanceCount: number;
+};
+
+// Dataset is the persisted data with its header,
+// data generated before the header was introduced is a bare DBInstance list.
+export type Dataset = {
+  // schemaVersion is the version of schema/dataset.v{schemaVersion}.json the data conforms to.
+  schemaVersion: number;
+  metadata: Metadata;
+  dbInstanceList: DBInstance[];
+};
+
+export type ShardBy = "provider" | "region" | "instance";
+
+// Shard is a file of the sharded data, which is a Dataset without metadata.
+export type Shard = {
+  shardBy: ShardBy;
+  // key is the provider, the region slug or the external ID of the shard.
+  key: string;
+  // path is relative to the manifest.
+  path: string;
+  instanceCount: number;
+  size: number;
+  sha256: string;
+};
+
+// Manifest is the index.json of the sharded data, pages only need to load the shards they need.
+export type Manifest = {
+  schemaVersion: number;
+  metadata: Metadata;
+  compressionList: ("gzip" | "br")[] | null;
+  shardList: Shard[];
 };
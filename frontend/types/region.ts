@@ -1,7 +1,22 @@
 import { Term } from "./term";
 
+export type Continent =
+  | "AFRICA"
+  | "ASIA"
+  | "EUROPE"
+  | "NORTH_AMERICA"
+  | "SOUTH_AMERICA"
+  | "OCEANIA";
+
 export type Region = {
   code: string;
+  // canonical fields, empty if the region is not included in the catalog.
+  slug: string;
+  name: string;
+  geography: string;
+  continent: Continent | "";
+  latitude: number;
+  longitude: number;
   termList: Term[];
 };
 
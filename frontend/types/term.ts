@@ -1,4 +1,4 @@
-import { EngineType } from "./common";
+import { EngineType, RowStatus } from "./common";
 
 export type ChargeType = "OnDemand" | "Reserved";
 export type ContractLength = "3yr" | "1yr";
@@ -11,11 +11,19 @@ export type TermPayload = {
 
 export type Term = {
   code: string;
+  rowStatus: RowStatus;
+  updatedTs: number;
+  archivedTs: number;
   databaseEngine: EngineType;
   type: ChargeType;
   payload: TermPayload;
   hourlyUSD: number;
   commitmentUSD: number;
+
+  // price-per-unit metrics derived from the effective hourly price.
+  usdPerCPUHour: number;
+  usdPerGiBHour: number;
+  pricePerformanceIndex: number;
 };
 
 export const isValidChargeType = (chargeTypeList: string[]): boolean => {
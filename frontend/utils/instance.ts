@@ -1,34 +1,23 @@
-import { CloudProvider } from "@/types";
+import { Dataset, DBInstance } from "@/types";
 
-export const getInstanceFamily = (
-  name: string,
-  provider: CloudProvider,
-  withoutSeparator: boolean = false
-): string => {
-  switch (provider) {
-    case "AWS":
-      if (withoutSeparator) {
-        return `${name.split(".")[1]}`;
-      }
-      return `${name.split(".").slice(0, 2).join(".")}.`;
-    case "GCP":
-      if (withoutSeparator) {
-        return `${name.split("-")[1]}`;
-      }
-      return `${name.split("-").slice(0, 2).join("-")}-`;
-    default:
-      return "";
-  }
-};
-
-export const getInstanceSize = (
-  name: string,
-  provider: CloudProvider
-): string => {
-  switch (provider) {
-    case "AWS":
-      return name.split(".")[2];
-    default:
-      return "";
-  }
+// getDBInstanceList returns the DBInstance list of the imported data,
+// which is either a Dataset with its header or a bare DBInstance list.
+// The instances and terms archived are excluded, as they are no longer offered.
+export const getDBInstanceList = (data: unknown): DBInstance[] => {
+  const dbInstanceList = Array.isArray(data)
+    ? (data as DBInstance[])
+    : (data as Dataset).dbInstanceList;
+  return dbInstanceList
+    .filter((dbInstance) => dbInstance.rowStatus !== "ARCHIVED")
+    .map((dbInstance) => ({
+      ...dbInstance,
+      regionList: dbInstance.regionList
+        .map((region) => ({
+          ...region,
+          termList: region.termList.filter(
+            (term) => term.rowStatus !== "ARCHIVED"
+          ),
+        }))
+        .filter((region) => region.termList.length > 0),
+    }));
 };
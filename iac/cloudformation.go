@@ -0,0 +1,120 @@
+package iac
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/dbcost/store"
+	"gopkg.in/yaml.v3"
+)
+
+// cloudFormationDBInstanceType is the resource type of the RDS instance in CloudFormation.
+const cloudFormationDBInstanceType = "AWS::RDS::DBInstance"
+
+// cloudFormationTemplate is the subset of a CloudFormation template,
+// see https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html
+type cloudFormationTemplate struct {
+	Parameters map[string]struct {
+		Default interface{} `yaml:"Default"`
+	} `yaml:"Parameters"`
+	Resources map[string]struct {
+		Type       string                 `yaml:"Type"`
+		Properties map[string]interface{} `yaml:"Properties"`
+	} `yaml:"Resources"`
+}
+
+// ParseCloudFormation parses the AWS::RDS::DBInstance resources in the CloudFormation template in JSON or YAML,
+// each resource is parsed as a change creating it, ordered by the logical ID.
+// The references to the parameters are resolved to their default values, and the other intrinsic functions are left unknown.
+// The region is taken from the availability zone, and defaultRegion is used if not specified, as the template is region agnostic.
+func ParseCloudFormation(dataByted []byte, defaultRegion string) ([]*Change, error) {
+	// YAML is a superset of JSON, so both are decoded as YAML.
+	var node yaml.Node
+	if err := yaml.Unmarshal(dataByted, &node); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the CloudFormation template, [internal]: %v", err)
+	}
+	expandShortForm(&node)
+	template := &cloudFormationTemplate{}
+	if err := node.Decode(template); err != nil {
+		return nil, fmt.Errorf("Fail to decode the CloudFormation template, [internal]: %v", err)
+	}
+	if template.Resources == nil {
+		return nil, fmt.Errorf("the CloudFormation template should have the Resources section")
+	}
+
+	var logicalIDList []string
+	for logicalID := range template.Resources {
+		logicalIDList = append(logicalIDList, logicalID)
+	}
+	sort.Strings(logicalIDList)
+
+	var changeList []*Change
+	for _, logicalID := range logicalIDList {
+		resource := template.Resources[logicalID]
+		if resource.Type != cloudFormationDBInstanceType {
+			continue
+		}
+		getProperty := func(key string) string {
+			return template.resolve(resource.Properties[key])
+		}
+		after := &Resource{
+			CloudProvider:    store.CloudProviderAWS,
+			InstanceType:     getProperty("DBInstanceClass"),
+			Engine:           getProperty("Engine"),
+			RegionCode:       defaultRegion,
+			HighAvailability: strings.EqualFold(getProperty("MultiAZ"), "true"),
+		}
+		if match := availabilityZonePattern.FindStringSubmatch(getProperty("AvailabilityZone")); match != nil {
+			after.RegionCode = match[1]
+		}
+		changeList = append(changeList, &Change{
+			Address: logicalID,
+			Type:    cloudFormationDBInstanceType,
+			Action:  ActionCreate,
+			After:   after,
+		})
+	}
+	return changeList, nil
+}
+
+// expandShortForm expands the short form of the intrinsic functions in YAML to the full form, e.g. !Ref X to Ref: X,
+// as the tags can not be decoded otherwise.
+func expandShortForm(node *yaml.Node) {
+	for _, child := range node.Content {
+		expandShortForm(child)
+	}
+	if !strings.HasPrefix(node.Tag, "!") || strings.HasPrefix(node.Tag, "!!") {
+		return
+	}
+	name := strings.TrimPrefix(node.Tag, "!")
+	if name != "Ref" {
+		name = "Fn::" + name
+	}
+	value := *node
+	value.Tag = ""
+	*node = yaml.Node{
+		Kind:    yaml.MappingNode,
+		Tag:     "!!map",
+		Content: []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}, &value},
+	}
+}
+
+// resolve returns the property as a string, with the references to the parameters resolved to their default values,
+// e.g. {"Ref": "DBInstanceClass"} in JSON or !Ref DBInstanceClass in YAML.
+// An empty string is returned if the value is not known until the stack is created.
+func (t *cloudFormationTemplate) resolve(value interface{}) string {
+	switch val := value.(type) {
+	case string:
+		return val
+	case bool, int, float64:
+		return fmt.Sprint(val)
+	case map[string]interface{}:
+		if ref, ok := val["Ref"].(string); ok {
+			if parameter, ok := t.Parameters[ref]; ok {
+				return t.resolve(parameter.Default)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,270 @@
+// Package iac estimates the cost of the database instances declared in infrastructure as code, e.g. Terraform plans.
+package iac
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/store"
+)
+
+// Action is the action applied to a resource.
+type Action string
+
+const (
+	// ActionCreate is the action for a resource to be created.
+	ActionCreate Action = "create"
+	// ActionUpdate is the action for a resource to be updated in place.
+	ActionUpdate Action = "update"
+	// ActionReplace is the action for a resource to be destroyed and created again.
+	ActionReplace Action = "replace"
+	// ActionDelete is the action for a resource to be destroyed.
+	ActionDelete Action = "delete"
+	// ActionNoOp is the action for a resource not changed.
+	ActionNoOp Action = "no-op"
+)
+
+// Resource is a database instance declared in the IaC input, with the attributes mapped to the dbcost data.
+type Resource struct {
+	CloudProvider string `json:"cloudProvider"`
+	// InstanceType is the instance class of AWS (e.g. db.r6g.xlarge) or the tier of GCP (e.g. db-n1-standard-4).
+	InstanceType string `json:"instanceType"`
+	// Engine is the engine as declared, e.g. aurora-postgresql, POSTGRES_15.
+	Engine     string `json:"engine"`
+	RegionCode string `json:"regionCode"`
+	// HighAvailability is true for AWS Multi-AZ and GCP regional instances.
+	HighAvailability bool `json:"highAvailability"`
+}
+
+// Change is the change of a resource, Before is nil if the resource is created and After is nil if the resource is deleted.
+type Change struct {
+	// Address is the identifier of the resource in the input, e.g. aws_db_instance.main.
+	Address string    `json:"address"`
+	Type    string    `json:"type"`
+	Action  Action    `json:"action"`
+	Before  *Resource `json:"before"`
+	After   *Resource `json:"after"`
+}
+
+// Cost is the monthly on-demand cost of a resource resolved against the catalog.
+type Cost struct {
+	Resource       *Resource         `json:"resource"`
+	ExternalID     string            `json:"externalId"`
+	DatabaseEngine client.EngineType `json:"databaseEngine"`
+	TermCode       string            `json:"termCode"`
+	// HourlyUSD and MonthlyUSD include the standby if the resource is high available.
+	HourlyUSD  float64 `json:"hourlyUSD"`
+	MonthlyUSD float64 `json:"monthlyUSD"`
+	// NoteList is the assumptions made to resolve the resource, e.g. the approximated instance.
+	NoteList []string `json:"noteList"`
+	// Error is the reason the resource can not be resolved, the cost is unknown if set.
+	Error string `json:"error,omitempty"`
+}
+
+// ResourceEstimate is the cost of a resource before and after the change.
+type ResourceEstimate struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	Action  Action `json:"action"`
+	Before  *Cost  `json:"before"`
+	After   *Cost  `json:"after"`
+	// DeltaMonthlyUSD is the monthly cost after the change minus the one before, nil if either side fails to resolve.
+	DeltaMonthlyUSD *float64 `json:"deltaMonthlyUSD"`
+}
+
+// Report is the cost estimation of the changes, the resources of the unknown delta are excluded from the totals.
+type Report struct {
+	ResourceList     []*ResourceEstimate `json:"resourceList"`
+	BeforeMonthlyUSD float64             `json:"beforeMonthlyUSD"`
+	AfterMonthlyUSD  float64             `json:"afterMonthlyUSD"`
+	DeltaMonthlyUSD  float64             `json:"deltaMonthlyUSD"`
+}
+
+// Estimate resolves the resources of the changes against the catalog, and sums up the monthly cost before and after the changes.
+// The cost of a resource is its cheapest on-demand term running all the time.
+// The resources failed to resolve are reported with the error, and the delta of the change is left unknown rather than counting the side as 0.
+func Estimate(catalog *store.Catalog, changeList []*Change) *Report {
+	report := &Report{}
+	for _, change := range changeList {
+		resourceEstimate := &ResourceEstimate{
+			Address: change.Address,
+			Type:    change.Type,
+			Action:  change.Action,
+		}
+		var beforeMonthlyUSD, afterMonthlyUSD float64
+		known := true
+		if change.Before != nil {
+			resourceEstimate.Before = resolve(catalog, change.Before)
+			beforeMonthlyUSD = resourceEstimate.Before.MonthlyUSD
+			known = known && resourceEstimate.Before.Error == ""
+		}
+		if change.After != nil {
+			resourceEstimate.After = resolve(catalog, change.After)
+			afterMonthlyUSD = resourceEstimate.After.MonthlyUSD
+			known = known && resourceEstimate.After.Error == ""
+		}
+		if known {
+			delta := afterMonthlyUSD - beforeMonthlyUSD
+			resourceEstimate.DeltaMonthlyUSD = &delta
+			report.BeforeMonthlyUSD += beforeMonthlyUSD
+			report.AfterMonthlyUSD += afterMonthlyUSD
+		}
+		report.ResourceList = append(report.ResourceList, resourceEstimate)
+	}
+	report.DeltaMonthlyUSD = report.AfterMonthlyUSD - report.BeforeMonthlyUSD
+	return report
+}
+
+// resolve resolves the resource to the cheapest on-demand term of its instance and engine in its region.
+func resolve(catalog *store.Catalog, resource *Resource) *Cost {
+	c := &Cost{Resource: resource}
+	engine, note, err := getEngine(resource)
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.DatabaseEngine = engine
+	if note != "" {
+		c.NoteList = append(c.NoteList, note)
+	}
+
+	dbInstance, note, err := findDBInstance(catalog, resource)
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.ExternalID = dbInstance.GetExternalID()
+	if note != "" {
+		c.NoteList = append(c.NoteList, note)
+	}
+
+	if resource.RegionCode == "" {
+		c.Error = "the region is unknown"
+		return c
+	}
+	var onDemand *store.Term
+	for _, r := range dbInstance.RegionList {
+		if r.Code != resource.RegionCode {
+			continue
+		}
+		for _, term := range r.TermList {
+			if term.IsArchived() || term.Type != client.ChargeTypeOnDemand || term.DatabaseEngine != engine {
+				continue
+			}
+			if onDemand == nil || term.HourlyUSD < onDemand.HourlyUSD {
+				onDemand = term
+			}
+		}
+	}
+	if onDemand == nil {
+		c.Error = fmt.Sprintf("no on-demand price of %s for %s found in %s", c.ExternalID, engine, resource.RegionCode)
+		return c
+	}
+
+	estimate, err := cost.Calculate(onDemand, cost.Months(1), 1)
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.TermCode = onDemand.Code
+	c.HourlyUSD = onDemand.HourlyUSD
+	c.MonthlyUSD = estimate.TotalUSD
+	if resource.HighAvailability {
+		c.HourlyUSD *= cost.HighAvailabilityFactor
+		c.MonthlyUSD *= cost.HighAvailabilityFactor
+		c.NoteList = append(c.NoteList, "high availability is estimated as twice the single-zone price for the standby")
+	}
+	return c
+}
+
+// getEngine maps the declared engine to the engine type of dbcost.
+func getEngine(resource *Resource) (client.EngineType, string, error) {
+	engine := strings.ToLower(resource.Engine)
+	switch {
+	case engine == "postgres" || strings.HasPrefix(engine, "postgres_"):
+		return client.EngineTypePostgreSQL, "", nil
+	case engine == "mysql" || strings.HasPrefix(engine, "mysql_"):
+		return client.EngineTypeMySQL, "", nil
+	case engine == "aurora-postgresql":
+		return client.EngineTypePostgreSQL, "Aurora is priced as RDS for PostgreSQL, as Aurora prices are not in the dataset", nil
+	case engine == "aurora" || engine == "aurora-mysql":
+		return client.EngineTypeMySQL, "Aurora is priced as RDS for MySQL, as Aurora prices are not in the dataset", nil
+	case engine == "":
+		return "", "", fmt.Errorf("the engine is unknown")
+	}
+	return "", "", fmt.Errorf("engine %q is not supported, supported engines are MySQL and PostgreSQL", resource.Engine)
+}
+
+// findDBInstance finds the instance of the resource in the catalog.
+//   - AWS instance classes are the same as the instance names, e.g. db.r6g.xlarge.
+//   - GCP predefined tiers (e.g. db-n1-standard-4) are matched by the series and the vCPU,
+//     and custom tiers (e.g. db-custom-4-16384) are approximated by the smallest instance with enough vCPU and memory.
+func findDBInstance(catalog *store.Catalog, resource *Resource) (*store.DBInstance, string, error) {
+	if resource.InstanceType == "" {
+		return nil, "", fmt.Errorf("the instance type is unknown")
+	}
+	switch resource.CloudProvider {
+	case store.CloudProviderAWS:
+		externalID := fmt.Sprintf("%s:%s", resource.CloudProvider, resource.InstanceType)
+		if dbInstance, ok := catalog.GetDBInstance(externalID); ok {
+			return dbInstance, "", nil
+		}
+		return nil, "", fmt.Errorf("instance %s not found", externalID)
+	case store.CloudProviderGCP:
+		return findGCPDBInstance(catalog, resource.InstanceType)
+	}
+	return nil, "", fmt.Errorf("cloud provider %q is not supported", resource.CloudProvider)
+}
+
+func findGCPDBInstance(catalog *store.Catalog, tier string) (*store.DBInstance, string, error) {
+	var candidateList []*store.DBInstance
+	for _, dbInstance := range catalog.ListDBInstance() {
+		if dbInstance.CloudProvider == store.CloudProviderGCP && !dbInstance.IsArchived() {
+			candidateList = append(candidateList, dbInstance)
+		}
+	}
+	sort.SliceStable(candidateList, func(i, j int) bool {
+		if candidateList[i].CPU != candidateList[j].CPU {
+			return candidateList[i].CPU < candidateList[j].CPU
+		}
+		return candidateList[i].GetMemoryGiB() < candidateList[j].GetMemoryGiB()
+	})
+
+	partList := strings.Split(strings.TrimPrefix(strings.ToLower(tier), "db-"), "-")
+	if partList[0] == "custom" {
+		if len(partList) != 3 {
+			return nil, "", fmt.Errorf("invalid custom tier %q, should be in the form of db-custom-${CPU}-${MEMORY_MB}", tier)
+		}
+		cpu, cpuErr := strconv.Atoi(partList[1])
+		memoryMB, memoryErr := strconv.Atoi(partList[2])
+		if cpuErr != nil || memoryErr != nil {
+			return nil, "", fmt.Errorf("invalid custom tier %q, should be in the form of db-custom-${CPU}-${MEMORY_MB}", tier)
+		}
+		memory := float64(memoryMB) / 1024
+		for _, dbInstance := range candidateList {
+			if dbInstance.CPU >= cpu && dbInstance.GetMemoryGiB() >= memory {
+				note := fmt.Sprintf("custom tier %s is approximated by %s, as custom tier prices are not in the dataset", tier, dbInstance.GetExternalID())
+				return dbInstance, note, nil
+			}
+		}
+		return nil, "", fmt.Errorf("no instance has at least %d vCPU and %v GiB for custom tier %s", cpu, memory, tier)
+	}
+
+	// e.g. db-n1-standard-4 is of series N1Standard with 4 vCPU, and db-f1-micro is of series F1Micro.
+	cpu := 0
+	if n, err := strconv.Atoi(partList[len(partList)-1]); err == nil {
+		cpu = n
+		partList = partList[:len(partList)-1]
+	}
+	series := strings.Join(partList, "")
+	for _, dbInstance := range candidateList {
+		if strings.EqualFold(dbInstance.Series, series) && (cpu == 0 || dbInstance.CPU == cpu) {
+			return dbInstance, "", nil
+		}
+	}
+	return nil, "", fmt.Errorf("tier %s not found", tier)
+}
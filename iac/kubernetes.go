@@ -0,0 +1,148 @@
+package iac
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytebase/dbcost/store"
+	"gopkg.in/yaml.v3"
+)
+
+// kubernetesObject is the subset of a Kubernetes object, the spec is decoded by the kind.
+type kubernetesObject struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Spec map[string]interface{} `yaml:"spec"`
+	// Items is the objects of a List.
+	Items []*kubernetesObject `yaml:"items"`
+}
+
+// ackRegionAnnotation is the annotation overriding the region of an ACK resource.
+const ackRegionAnnotation = "services.k8s.aws/region"
+
+// ParseKubernetes parses the database instances in the Kubernetes manifests, which may contain multiple documents and Lists.
+// Each instance is parsed as a change creating it, and the supported kinds are
+//   - Crossplane RDSInstance of database.aws.crossplane.io.
+//   - Crossplane CloudSQLInstance of database.gcp.crossplane.io.
+//   - ACK DBInstance of rds.services.k8s.aws.
+//
+// defaultRegion is used if the region is not specified, e.g. ACK resources use the region of the controller by default.
+func ParseKubernetes(dataByted []byte, defaultRegion string) ([]*Change, error) {
+	var objectList []*kubernetesObject
+	decoder := yaml.NewDecoder(bytes.NewReader(dataByted))
+	for {
+		object := &kubernetesObject{}
+		if err := decoder.Decode(object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("Fail to unmarshal the Kubernetes manifest, [internal]: %v", err)
+		}
+		// e.g. List, DBInstanceList
+		if strings.HasSuffix(object.Kind, "List") {
+			objectList = append(objectList, object.Items...)
+			continue
+		}
+		objectList = append(objectList, object)
+	}
+
+	var changeList []*Change
+	for _, object := range objectList {
+		if object == nil {
+			continue
+		}
+		after := newKubernetesResource(object, defaultRegion)
+		if after == nil {
+			continue
+		}
+		changeList = append(changeList, &Change{
+			Address: object.getAddress(),
+			Type:    object.Kind,
+			Action:  ActionCreate,
+			After:   after,
+		})
+	}
+	return changeList, nil
+}
+
+// newKubernetesResource returns the resource of the object, nil if the kind is not supported.
+func newKubernetesResource(object *kubernetesObject, defaultRegion string) *Resource {
+	group, _, _ := strings.Cut(object.APIVersion, "/")
+	switch {
+	case group == "database.aws.crossplane.io" && object.Kind == "RDSInstance":
+		// see https://marketplace.upbound.io/providers/crossplane-contrib/provider-aws/latest/resources/database.aws.crossplane.io/RDSInstance/v1beta1
+		forProvider := getMap(object.Spec, "forProvider")
+		resource := &Resource{
+			CloudProvider:    store.CloudProviderAWS,
+			InstanceType:     getString(forProvider, "dbInstanceClass"),
+			Engine:           getString(forProvider, "engine"),
+			RegionCode:       getString(forProvider, "region"),
+			HighAvailability: getBool(forProvider, "multiAZ"),
+		}
+		if match := availabilityZonePattern.FindStringSubmatch(getString(forProvider, "availabilityZone")); match != nil {
+			resource.RegionCode = match[1]
+		}
+		if resource.RegionCode == "" {
+			resource.RegionCode = defaultRegion
+		}
+		return resource
+	case group == "database.gcp.crossplane.io" && object.Kind == "CloudSQLInstance":
+		// see https://marketplace.upbound.io/providers/crossplane-contrib/provider-gcp/latest/resources/database.gcp.crossplane.io/CloudSQLInstance/v1beta1
+		forProvider := getMap(object.Spec, "forProvider")
+		settings := getMap(forProvider, "settings")
+		resource := &Resource{
+			CloudProvider:    store.CloudProviderGCP,
+			InstanceType:     getString(settings, "tier"),
+			Engine:           getString(forProvider, "databaseVersion"),
+			RegionCode:       getString(forProvider, "region"),
+			HighAvailability: getString(settings, "availabilityType") == "REGIONAL",
+		}
+		if resource.RegionCode == "" {
+			resource.RegionCode = defaultRegion
+		}
+		return resource
+	case group == "rds.services.k8s.aws" && object.Kind == "DBInstance":
+		// see https://aws-controllers-k8s.github.io/community/reference/rds/v1alpha1/dbinstance/
+		resource := &Resource{
+			CloudProvider:    store.CloudProviderAWS,
+			InstanceType:     getString(object.Spec, "dbInstanceClass"),
+			Engine:           getString(object.Spec, "engine"),
+			RegionCode:       object.Metadata.Annotations[ackRegionAnnotation],
+			HighAvailability: getBool(object.Spec, "multiAZ"),
+		}
+		if match := availabilityZonePattern.FindStringSubmatch(getString(object.Spec, "availabilityZone")); match != nil {
+			resource.RegionCode = match[1]
+		}
+		if resource.RegionCode == "" {
+			resource.RegionCode = defaultRegion
+		}
+		return resource
+	}
+	return nil
+}
+
+// getAddress returns the identifier of the object, e.g. RDSInstance/default/main.
+func (o *kubernetesObject) getAddress() string {
+	if o.Metadata.Namespace == "" {
+		return fmt.Sprintf("%s/%s", o.Kind, o.Metadata.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", o.Kind, o.Metadata.Namespace, o.Metadata.Name)
+}
+
+func getMap(attributes map[string]interface{}, key string) map[string]interface{} {
+	value, _ := attributes[key].(map[string]interface{})
+	return value
+}
+
+func getBool(attributes map[string]interface{}, key string) bool {
+	value, _ := attributes[key].(bool)
+	return value
+}
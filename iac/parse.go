@@ -0,0 +1,50 @@
+package iac
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the format of the IaC input.
+type Format string
+
+const (
+	// FormatTerraform is the plan output of `terraform show -json`.
+	FormatTerraform Format = "terraform"
+	// FormatCloudFormation is the CloudFormation template in JSON or YAML.
+	FormatCloudFormation Format = "cloudformation"
+	// FormatKubernetes is the Kubernetes manifests in YAML or JSON.
+	FormatKubernetes Format = "kubernetes"
+)
+
+// DetectFormat detects the format of the IaC input by its top-level keys.
+func DetectFormat(dataByted []byte) (Format, error) {
+	// only the first document is decoded for the Kubernetes manifests with multiple documents.
+	var document map[string]interface{}
+	if err := yaml.Unmarshal(dataByted, &document); err != nil {
+		return "", fmt.Errorf("Fail to unmarshal the input, [internal]: %v", err)
+	}
+	switch {
+	case document["format_version"] != nil && document["resource_changes"] != nil:
+		return FormatTerraform, nil
+	case document["Resources"] != nil:
+		return FormatCloudFormation, nil
+	case document["apiVersion"] != nil && document["kind"] != nil:
+		return FormatKubernetes, nil
+	}
+	return "", fmt.Errorf("unknown input format, supported formats are Terraform plan JSON, CloudFormation template and Kubernetes manifest")
+}
+
+// Parse parses the changes of the database instances in the IaC input of the format.
+func Parse(format Format, dataByted []byte, defaultRegion string) ([]*Change, error) {
+	switch format {
+	case FormatTerraform:
+		return ParseTerraformPlan(dataByted, defaultRegion)
+	case FormatCloudFormation:
+		return ParseCloudFormation(dataByted, defaultRegion)
+	case FormatKubernetes:
+		return ParseKubernetes(dataByted, defaultRegion)
+	}
+	return nil, fmt.Errorf("unknown format %q, allowed formats are terraform, cloudformation and kubernetes", format)
+}
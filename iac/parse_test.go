@@ -0,0 +1,76 @@
+package iac
+
+import (
+	"os"
+	"testing"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectFormat(t *testing.T) {
+	tests := []struct {
+		filePath string
+		want     Format
+	}{
+		{"testdata/plan.json", FormatTerraform},
+		{"testdata/template.yaml", FormatCloudFormation},
+		{"testdata/template.json", FormatCloudFormation},
+		{"testdata/manifest.yaml", FormatKubernetes},
+	}
+	for _, test := range tests {
+		dataByted, err := os.ReadFile(test.filePath)
+		require.NoError(t, err)
+		format, err := DetectFormat(dataByted)
+		require.NoError(t, err)
+		require.Equal(t, test.want, format, test.filePath)
+	}
+
+	_, err := DetectFormat([]byte(`{"foo": "bar"}`))
+	require.Error(t, err)
+}
+
+func Test_ParseCloudFormation(t *testing.T) {
+	dataByted, err := os.ReadFile("testdata/template.yaml")
+	require.NoError(t, err)
+	changeList, err := ParseCloudFormation(dataByted, "us-east-1")
+	require.NoError(t, err)
+	require.Len(t, changeList, 2)
+	// the parameter is resolved to its default value, and the region defaults to the given one.
+	require.Equal(t, "Primary", changeList[0].Address)
+	require.Equal(t, ActionCreate, changeList[0].Action)
+	require.Equal(t, &Resource{CloudProvider: "AWS", InstanceType: "db.r6g.4xlarge", Engine: "postgres", RegionCode: "us-east-1", HighAvailability: true}, changeList[0].After)
+	// the region is taken from the availability zone.
+	require.Equal(t, &Resource{CloudProvider: "AWS", InstanceType: "db.r6g.4xlarge", Engine: "mysql", RegionCode: "ap-south-1"}, changeList[1].After)
+
+	dataByted, err = os.ReadFile("testdata/template.json")
+	require.NoError(t, err)
+	changeList, err = ParseCloudFormation(dataByted, "us-east-1")
+	require.NoError(t, err)
+	require.Len(t, changeList, 2)
+	require.Equal(t, "db.r6g.4xlarge", changeList[0].After.InstanceType)
+	require.True(t, changeList[0].After.HighAvailability)
+	// the instance class not known until the stack is created is left empty.
+	require.Equal(t, "", changeList[1].After.InstanceType)
+}
+
+func Test_ParseKubernetes(t *testing.T) {
+	dataByted, err := os.ReadFile("testdata/manifest.yaml")
+	require.NoError(t, err)
+	changeList, err := ParseKubernetes(dataByted, "")
+	require.NoError(t, err)
+	require.Len(t, changeList, 3)
+	require.Equal(t, "RDSInstance/main", changeList[0].Address)
+	require.Equal(t, &Resource{CloudProvider: "AWS", InstanceType: "db.r6g.4xlarge", Engine: "postgres", RegionCode: "us-east-1", HighAvailability: true}, changeList[0].After)
+	require.Equal(t, "CloudSQLInstance/analytics", changeList[1].Address)
+	require.Equal(t, &Resource{CloudProvider: "GCP", InstanceType: "db-n1-standard-96", Engine: "MYSQL_8_0", RegionCode: "us-east4"}, changeList[1].After)
+	require.Equal(t, "DBInstance/data/reporting", changeList[2].Address)
+	require.Equal(t, &Resource{CloudProvider: "AWS", InstanceType: "db.r6g.4xlarge", Engine: "mysql", RegionCode: "ap-south-1"}, changeList[2].After)
+
+	// the manifests are estimated the same as the other inputs.
+	catalog, err := store.LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+	report := Estimate(catalog, changeList)
+	require.InDelta(t, (2.158*2+10.158+1.933)*730, report.AfterMonthlyUSD, 1e-6)
+	require.Equal(t, 0.0, report.BeforeMonthlyUSD)
+}
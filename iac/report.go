@@ -0,0 +1,117 @@
+package iac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markdown renders the report as markdown, which is intended to be posted as a PR comment.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+	b.WriteString("## Database cost estimation\n\n")
+	if len(r.ResourceList) == 0 {
+		b.WriteString("No database instance found.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Monthly cost: %s → %s (%s)\n\n", formatUSD(r.BeforeMonthlyUSD), formatUSD(r.AfterMonthlyUSD), formatDelta(r.DeltaMonthlyUSD))
+	b.WriteString("| Resource | Action | Instance | Region | Before | After | Delta |\n| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, resource := range r.ResourceList {
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s | %s | %s |\n",
+			resource.Address, resource.Action, getInstance(resource), getRegion(resource),
+			formatCost(resource.Before), formatCost(resource.After), formatResourceDelta(resource.DeltaMonthlyUSD))
+	}
+
+	var noteList []string
+	for _, resource := range r.ResourceList {
+		for _, c := range []*Cost{resource.Before, resource.After} {
+			if c == nil {
+				continue
+			}
+			if c.Error != "" {
+				noteList = append(noteList, fmt.Sprintf("- `%s`: %s, excluded from the total.", resource.Address, c.Error))
+			}
+			for _, note := range c.NoteList {
+				noteList = append(noteList, fmt.Sprintf("- `%s`: %s.", resource.Address, note))
+			}
+		}
+	}
+	if len(noteList) > 0 {
+		b.WriteString("\n### Notes\n\n")
+		b.WriteString(strings.Join(dedupe(noteList), "\n"))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n_Costs are the monthly on-demand prices running all the time._\n")
+	return b.String()
+}
+
+// getInstance returns the instance after the change, or the one before if the resource is deleted.
+// Both are returned if the instance is changed, e.g. db.t3.large → db.r6g.large.
+func getInstance(resource *ResourceEstimate) string {
+	return getChangedValue(resource, func(c *Cost) string {
+		if c.ExternalID != "" {
+			return c.ExternalID
+		}
+		return c.Resource.InstanceType
+	})
+}
+
+func getRegion(resource *ResourceEstimate) string {
+	return getChangedValue(resource, func(c *Cost) string { return c.Resource.RegionCode })
+}
+
+func getChangedValue(resource *ResourceEstimate, getValue func(c *Cost) string) string {
+	switch {
+	case resource.Before == nil && resource.After == nil:
+		return ""
+	case resource.Before == nil:
+		return getValue(resource.After)
+	case resource.After == nil:
+		return getValue(resource.Before)
+	}
+	before, after := getValue(resource.Before), getValue(resource.After)
+	if before == after {
+		return after
+	}
+	return fmt.Sprintf("%s → %s", before, after)
+}
+
+func formatCost(c *Cost) string {
+	if c == nil {
+		return "-"
+	}
+	if c.Error != "" {
+		return "unknown"
+	}
+	return formatUSD(c.MonthlyUSD)
+}
+
+func formatResourceDelta(usd *float64) string {
+	if usd == nil {
+		return "unknown"
+	}
+	return formatDelta(*usd)
+}
+
+func formatUSD(usd float64) string {
+	return fmt.Sprintf("$%.2f", usd)
+}
+
+func formatDelta(usd float64) string {
+	if usd < 0 {
+		return fmt.Sprintf("-$%.2f", -usd)
+	}
+	return fmt.Sprintf("+$%.2f", usd)
+}
+
+// dedupe removes the duplicated items while keeping the order, e.g. the same note before and after an update.
+func dedupe(list []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, item := range list {
+		if !seen[item] {
+			seen[item] = true
+			result = append(result, item)
+		}
+	}
+	return result
+}
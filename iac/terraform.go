@@ -0,0 +1,203 @@
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+// terraformPlan is the subset of the plan output of `terraform show -json`,
+// see https://developer.hashicorp.com/terraform/internals/json-format
+type terraformPlan struct {
+	FormatVersion   string                     `json:"format_version"`
+	ResourceChanges []*terraformResourceChange `json:"resource_changes"`
+	Configuration   struct {
+		ProviderConfig map[string]*terraformProviderConfig `json:"provider_config"`
+		RootModule     *terraformModule                    `json:"root_module"`
+	} `json:"configuration"`
+}
+
+type terraformResourceChange struct {
+	Address      string `json:"address"`
+	Mode         string `json:"mode"`
+	Type         string `json:"type"`
+	ProviderName string `json:"provider_name"`
+	Change       struct {
+		Actions []string               `json:"actions"`
+		Before  map[string]interface{} `json:"before"`
+		After   map[string]interface{} `json:"after"`
+	} `json:"change"`
+}
+
+type terraformProviderConfig struct {
+	Name        string `json:"name"`
+	Expressions struct {
+		Region *struct {
+			ConstantValue interface{} `json:"constant_value"`
+		} `json:"region"`
+	} `json:"expressions"`
+}
+
+type terraformModule struct {
+	Resources []struct {
+		Address           string `json:"address"`
+		ProviderConfigKey string `json:"provider_config_key"`
+	} `json:"resources"`
+	ModuleCalls map[string]struct {
+		Module *terraformModule `json:"module"`
+	} `json:"module_calls"`
+}
+
+// terraformCloudProviderMap is the cloud provider of the supported resource types.
+var terraformCloudProviderMap = map[string]string{
+	"aws_db_instance":              store.CloudProviderAWS,
+	"aws_rds_cluster_instance":     store.CloudProviderAWS,
+	"google_sql_database_instance": store.CloudProviderGCP,
+}
+
+// availabilityZonePattern matches the AWS availability zone, e.g. us-east-1a, and captures its region.
+var availabilityZonePattern = regexp.MustCompile(`^([a-z]{2}(?:-[a-z]+)+-\d+)[a-z]$`)
+
+// moduleIndexPattern matches the index of a module in a count or for_each, e.g. module.db["main"].
+var moduleIndexPattern = regexp.MustCompile(`\[[^\]]*\]\.`)
+
+// ParseTerraformPlan parses the changes of the database instances in the plan output of `terraform show -json`,
+// i.e. aws_db_instance, aws_rds_cluster_instance and google_sql_database_instance.
+// The region of a resource is taken from its attributes, then from the region of its provider configuration,
+// and defaultRegion is used if neither is known, e.g. the region is passed in as a variable.
+func ParseTerraformPlan(dataByted []byte, defaultRegion string) ([]*Change, error) {
+	plan := &terraformPlan{}
+	if err := json.Unmarshal(dataByted, plan); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the terraform plan, [internal]: %v", err)
+	}
+	if plan.FormatVersion == "" {
+		return nil, fmt.Errorf("the terraform plan should be the output of `terraform show -json`")
+	}
+
+	providerKeyMap := make(map[string]string)
+	collectProviderConfigKey(plan.Configuration.RootModule, "", providerKeyMap)
+
+	var changeList []*Change
+	for _, resourceChange := range plan.ResourceChanges {
+		cloudProvider, ok := terraformCloudProviderMap[resourceChange.Type]
+		if !ok || resourceChange.Mode != "managed" {
+			continue
+		}
+		action, ok := getTerraformAction(resourceChange.Change.Actions)
+		if !ok {
+			continue
+		}
+
+		providerRegion := defaultRegion
+		if providerConfig := plan.getProviderConfig(resourceChange, providerKeyMap); providerConfig != nil && providerConfig.Expressions.Region != nil {
+			if region, ok := providerConfig.Expressions.Region.ConstantValue.(string); ok && region != "" {
+				providerRegion = region
+			}
+		}
+		change := &Change{
+			Address: resourceChange.Address,
+			Type:    resourceChange.Type,
+			Action:  action,
+		}
+		if resourceChange.Change.Before != nil {
+			change.Before = newTerraformResource(resourceChange.Type, cloudProvider, resourceChange.Change.Before, providerRegion)
+		}
+		if resourceChange.Change.After != nil {
+			change.After = newTerraformResource(resourceChange.Type, cloudProvider, resourceChange.Change.After, providerRegion)
+		}
+		changeList = append(changeList, change)
+	}
+	return changeList, nil
+}
+
+// getTerraformAction maps the actions of the change, the changes not applied to the resource (e.g. read) are skipped.
+func getTerraformAction(actionList []string) (Action, bool) {
+	switch strings.Join(actionList, ",") {
+	case "create":
+		return ActionCreate, true
+	case "update":
+		return ActionUpdate, true
+	case "delete":
+		return ActionDelete, true
+	case "no-op":
+		return ActionNoOp, true
+	case "delete,create", "create,delete":
+		return ActionReplace, true
+	}
+	return "", false
+}
+
+// collectProviderConfigKey collects the provider configuration key of the resources in the module and its children keyed by the resource address.
+func collectProviderConfigKey(module *terraformModule, prefix string, providerKeyMap map[string]string) {
+	if module == nil {
+		return
+	}
+	for _, resource := range module.Resources {
+		providerKeyMap[prefix+resource.Address] = resource.ProviderConfigKey
+	}
+	for name, call := range module.ModuleCalls {
+		collectProviderConfigKey(call.Module, prefix+"module."+name+".", providerKeyMap)
+	}
+}
+
+// getProviderConfig returns the provider configuration of the resource, the default configuration of the provider is used if not found.
+func (p *terraformPlan) getProviderConfig(resourceChange *terraformResourceChange, providerKeyMap map[string]string) *terraformProviderConfig {
+	// the address of a resource in a count or for_each ends with the index, e.g. aws_db_instance.replica[0].
+	address := resourceChange.Address
+	if i := strings.LastIndex(address, "["); i > 0 && strings.HasSuffix(address, "]") {
+		address = address[:i]
+	}
+	// the module address of a module in a count or for_each ends with the index as well.
+	address = moduleIndexPattern.ReplaceAllString(address, ".")
+	if key, ok := providerKeyMap[address]; ok {
+		if providerConfig, ok := p.Configuration.ProviderConfig[key]; ok {
+			return providerConfig
+		}
+		// the key of a provider inherited by a module is prefixed with the module name, e.g. db:aws.
+		if i := strings.LastIndex(key, ":"); i >= 0 {
+			if providerConfig, ok := p.Configuration.ProviderConfig[key[i+1:]]; ok {
+				return providerConfig
+			}
+		}
+	}
+	// e.g. registry.terraform.io/hashicorp/aws
+	name := resourceChange.ProviderName[strings.LastIndex(resourceChange.ProviderName, "/")+1:]
+	return p.Configuration.ProviderConfig[name]
+}
+
+func newTerraformResource(resourceType, cloudProvider string, attributes map[string]interface{}, providerRegion string) *Resource {
+	resource := &Resource{
+		CloudProvider: cloudProvider,
+		RegionCode:    providerRegion,
+	}
+	switch resourceType {
+	case "aws_db_instance", "aws_rds_cluster_instance":
+		resource.InstanceType = getString(attributes, "instance_class")
+		resource.Engine = getString(attributes, "engine")
+		resource.HighAvailability, _ = attributes["multi_az"].(bool)
+		if match := availabilityZonePattern.FindStringSubmatch(getString(attributes, "availability_zone")); match != nil {
+			resource.RegionCode = match[1]
+		}
+	case "google_sql_database_instance":
+		resource.Engine = getString(attributes, "database_version")
+		if region := getString(attributes, "region"); region != "" {
+			resource.RegionCode = region
+		}
+		// settings is a block list with at most one item.
+		if settingsList, ok := attributes["settings"].([]interface{}); ok && len(settingsList) > 0 {
+			if settings, ok := settingsList[0].(map[string]interface{}); ok {
+				resource.InstanceType = getString(settings, "tier")
+				resource.HighAvailability = getString(settings, "availability_type") == "REGIONAL"
+			}
+		}
+	}
+	return resource
+}
+
+func getString(attributes map[string]interface{}, key string) string {
+	value, _ := attributes[key].(string)
+	return value
+}
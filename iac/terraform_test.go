@@ -0,0 +1,100 @@
+package iac
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseTerraformPlan(t *testing.T) {
+	dataByted, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+	changeList, err := ParseTerraformPlan(dataByted, "")
+	require.NoError(t, err)
+	// the bucket and the data source are skipped.
+	require.Len(t, changeList, 4)
+
+	main := changeList[0]
+	require.Equal(t, ActionUpdate, main.Action)
+	require.Equal(t, &Resource{CloudProvider: "AWS", InstanceType: "db.r6g.4xlarge", Engine: "postgres", RegionCode: "us-east-1"}, main.Before)
+	require.True(t, main.After.HighAvailability)
+	require.Equal(t, "us-east-1", main.After.RegionCode)
+
+	// the region is taken from the aliased provider of the module.
+	reader := changeList[1]
+	require.Equal(t, ActionCreate, reader.Action)
+	require.Nil(t, reader.Before)
+	require.Equal(t, "ap-south-1", reader.After.RegionCode)
+
+	analytics := changeList[2]
+	require.Equal(t, ActionDelete, analytics.Action)
+	require.Equal(t, &Resource{CloudProvider: "GCP", InstanceType: "db-n1-standard-96", Engine: "MYSQL_8_0", RegionCode: "us-east4"}, analytics.Before)
+	require.Nil(t, analytics.After)
+
+	require.Equal(t, ActionReplace, changeList[3].Action)
+
+	_, err = ParseTerraformPlan([]byte(`{"resource_changes": []}`), "")
+	require.Error(t, err)
+}
+
+func Test_Estimate(t *testing.T) {
+	catalog, err := store.LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+	dataByted, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+	changeList, err := ParseTerraformPlan(dataByted, "")
+	require.NoError(t, err)
+
+	report := Estimate(catalog, changeList)
+	require.Len(t, report.ResourceList, 4)
+
+	// Multi-AZ doubles the cost.
+	main := report.ResourceList[0]
+	require.Equal(t, "AAA.a", main.Before.TermCode)
+	require.InDelta(t, 2.158*730, main.Before.MonthlyUSD, 1e-6)
+	require.InDelta(t, 2.158*730*2, main.After.MonthlyUSD, 1e-6)
+	require.InDelta(t, 2.158*730, *main.DeltaMonthlyUSD, 1e-6)
+
+	// Aurora is priced as RDS with a note.
+	reader := report.ResourceList[1]
+	require.Equal(t, "BBB.b", reader.After.TermCode)
+	require.Len(t, reader.After.NoteList, 1)
+
+	analytics := report.ResourceList[2]
+	require.Equal(t, "GCP:db-N1Standard-96-360", analytics.Before.ExternalID)
+	require.InDelta(t, -10.158*730, *analytics.DeltaMonthlyUSD, 1e-6)
+
+	// the delta of the instance not in the catalog is unknown, and it is excluded from the totals.
+	legacy := report.ResourceList[3]
+	require.NotEmpty(t, legacy.Before.Error)
+	require.Nil(t, legacy.DeltaMonthlyUSD)
+	require.InDelta(t, 2.158*730+10.158*730, report.BeforeMonthlyUSD, 1e-6)
+
+	require.InDelta(t, report.AfterMonthlyUSD-report.BeforeMonthlyUSD, report.DeltaMonthlyUSD, 1e-6)
+	markdown := report.Markdown()
+	require.True(t, strings.Contains(markdown, "| `aws_db_instance.legacy` | replace | db.m5.large → AWS:db.r6g.4xlarge | us-east-1 | unknown | $1489.93 | unknown |"), markdown)
+}
+
+func Test_FindGCPDBInstance(t *testing.T) {
+	catalog, err := store.LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+
+	dbInstance, note, err := findGCPDBInstance(catalog, "db-n1-standard-96")
+	require.NoError(t, err)
+	require.Equal(t, "GCP:db-N1Standard-96-360", dbInstance.GetExternalID())
+	require.Empty(t, note)
+
+	// custom tiers are approximated by the smallest instance large enough.
+	dbInstance, note, err = findGCPDBInstance(catalog, "db-custom-8-32768")
+	require.NoError(t, err)
+	require.Equal(t, "GCP:db-N1Standard-96-360", dbInstance.GetExternalID())
+	require.NotEmpty(t, note)
+
+	_, _, err = findGCPDBInstance(catalog, "db-custom-128-32768")
+	require.Error(t, err)
+	_, _, err = findGCPDBInstance(catalog, "db-n1-highmem-4")
+	require.Error(t, err)
+}
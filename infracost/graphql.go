@@ -0,0 +1,317 @@
+package infracost
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// ProductFilter is the filter of the products, empty fields match all.
+type ProductFilter struct {
+	VendorName       string
+	Service          string
+	ProductFamily    string
+	Region           string
+	SKU              string
+	AttributeFilters []*AttributeFilter
+}
+
+// AttributeFilter matches the attribute by the value, or by the regex in the form of /pattern/flags, e.g. /db\.t3\..*/i.
+type AttributeFilter struct {
+	Key        string
+	Value      string
+	ValueRegex string
+}
+
+// PriceFilter is the filter of the prices, empty fields match all.
+type PriceFilter struct {
+	PurchaseOption     string
+	Unit               string
+	Description        string
+	DescriptionRegex   string
+	TermLength         string
+	TermPurchaseOption string
+	TermOfferingClass  string
+}
+
+// Pricing is the products indexed by the vendor and the region.
+type Pricing struct {
+	productList []*Product
+	// regionIndex is keyed by vendor/region.
+	regionIndex map[string][]*Product
+}
+
+// NewPricing builds the index of the products.
+func NewPricing(productList []*Product) *Pricing {
+	p := &Pricing{
+		productList: productList,
+		regionIndex: make(map[string][]*Product),
+	}
+	for _, product := range productList {
+		key := product.VendorName + "/" + product.Region
+		p.regionIndex[key] = append(p.regionIndex[key], product)
+	}
+	return p
+}
+
+// FindProduct returns the products matching the filter.
+func (p *Pricing) FindProduct(filter *ProductFilter) ([]*Product, error) {
+	candidateList := p.productList
+	if filter.VendorName != "" && filter.Region != "" {
+		candidateList = p.regionIndex[filter.VendorName+"/"+filter.Region]
+	}
+
+	var matcherList []func(product *Product) bool
+	for _, attributeFilter := range filter.AttributeFilters {
+		match, err := newMatcher(attributeFilter.Value, attributeFilter.ValueRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter of attribute %s, %v", attributeFilter.Key, err)
+		}
+		key := attributeFilter.Key
+		matcherList = append(matcherList, func(product *Product) bool {
+			return match(product.GetAttribute(key))
+		})
+	}
+
+	var productList []*Product
+	for _, product := range candidateList {
+		if !matchString(filter.VendorName, product.VendorName) || !matchString(filter.Service, product.Service) ||
+			!matchString(filter.ProductFamily, product.ProductFamily) || !matchString(filter.Region, product.Region) ||
+			!matchString(filter.SKU, product.SKU) {
+			continue
+		}
+		matched := true
+		for _, match := range matcherList {
+			if !match(product) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			productList = append(productList, product)
+		}
+	}
+	return productList, nil
+}
+
+// FindPrice returns the prices of the product matching the filter.
+func FindPrice(product *Product, filter *PriceFilter) ([]*Price, error) {
+	if filter == nil {
+		return product.PriceList, nil
+	}
+	matchDescription, err := newMatcher(filter.Description, filter.DescriptionRegex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter of description, %v", err)
+	}
+	var priceList []*Price
+	for _, price := range product.PriceList {
+		if matchString(filter.PurchaseOption, price.PurchaseOption) && matchString(filter.Unit, price.Unit) &&
+			matchString(filter.TermLength, price.TermLength) && matchString(filter.TermPurchaseOption, price.TermPurchaseOption) &&
+			matchString(filter.TermOfferingClass, price.TermOfferingClass) && matchDescription(price.Description) {
+			priceList = append(priceList, price)
+		}
+	}
+	return priceList, nil
+}
+
+func matchString(expected, actual string) bool {
+	return expected == "" || expected == actual
+}
+
+// newMatcher returns the matcher of the value, or of the regex in the form of /pattern/flags if the value is empty.
+func newMatcher(value, valueRegex string) (func(s string) bool, error) {
+	if valueRegex == "" {
+		return func(s string) bool { return matchString(value, s) }, nil
+	}
+	pattern := valueRegex
+	if strings.HasPrefix(pattern, "/") {
+		if i := strings.LastIndex(pattern, "/"); i > 0 {
+			flags := pattern[i+1:]
+			pattern = pattern[1:i]
+			if strings.Contains(flags, "i") {
+				pattern = "(?i)" + pattern
+			}
+		}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
+
+// NewSchema returns the GraphQL schema of the subset of the Infracost pricing API:
+//
+//	products(filter: ProductFilter!): [Product]
+//
+// with prices(filter: PriceFilter) on each product.
+func NewSchema(pricing *Pricing) (graphql.Schema, error) {
+	attributeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Attribute",
+		Fields: graphql.Fields{
+			"key":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"value": &graphql.Field{Type: graphql.String},
+		},
+	})
+	priceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Price",
+		Fields: graphql.Fields{
+			"priceHash":          newStringField(func(p *Price) string { return p.PriceHash }),
+			"purchaseOption":     newStringField(func(p *Price) string { return p.PurchaseOption }),
+			"unit":               newStringField(func(p *Price) string { return p.Unit }),
+			"USD":                newStringField(func(p *Price) string { return p.USD }),
+			"description":        newStringField(func(p *Price) string { return p.Description }),
+			"termLength":         newStringField(func(p *Price) string { return p.TermLength }),
+			"termPurchaseOption": newStringField(func(p *Price) string { return p.TermPurchaseOption }),
+			"termOfferingClass":  newStringField(func(p *Price) string { return p.TermOfferingClass }),
+			// the fields below are always null, as dbcost does not have tiered or dated prices.
+			"CNY":                &graphql.Field{Type: graphql.String},
+			"effectiveDateStart": &graphql.Field{Type: graphql.String},
+			"effectiveDateEnd":   &graphql.Field{Type: graphql.String},
+			"startUsageAmount":   &graphql.Field{Type: graphql.String},
+			"endUsageAmount":     &graphql.Field{Type: graphql.String},
+		},
+	})
+	priceFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "PriceFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"purchaseOption":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"unit":               &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"description":        &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"description_regex":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"termLength":         &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"termPurchaseOption": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"termOfferingClass":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"startUsageAmount":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"endUsageAmount":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+	productType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Product",
+		Fields: graphql.Fields{
+			"productHash":   newProductStringField(func(p *Product) string { return p.ProductHash }),
+			"sku":           newProductStringField(func(p *Product) string { return p.SKU }),
+			"vendorName":    newProductStringField(func(p *Product) string { return p.VendorName }),
+			"region":        newProductStringField(func(p *Product) string { return p.Region }),
+			"service":       newProductStringField(func(p *Product) string { return p.Service }),
+			"productFamily": newProductStringField(func(p *Product) string { return p.ProductFamily }),
+			"attributes": &graphql.Field{
+				Type: graphql.NewList(attributeType),
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					var attributeList []map[string]interface{}
+					for _, attribute := range params.Source.(*Product).AttributeList {
+						attributeList = append(attributeList, map[string]interface{}{"key": attribute.Key, "value": attribute.Value})
+					}
+					return attributeList, nil
+				},
+			},
+			"prices": &graphql.Field{
+				Type: graphql.NewList(priceType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: priceFilterType},
+				},
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					var filter *PriceFilter
+					if arg, ok := params.Args["filter"].(map[string]interface{}); ok {
+						filter = &PriceFilter{
+							PurchaseOption:     getString(arg, "purchaseOption"),
+							Unit:               getString(arg, "unit"),
+							Description:        getString(arg, "description"),
+							DescriptionRegex:   getString(arg, "description_regex"),
+							TermLength:         getString(arg, "termLength"),
+							TermPurchaseOption: getString(arg, "termPurchaseOption"),
+							TermOfferingClass:  getString(arg, "termOfferingClass"),
+						}
+					}
+					return FindPrice(params.Source.(*Product), filter)
+				},
+			},
+		},
+	})
+
+	attributeFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AttributeFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"key":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"value":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"value_regex": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+	productFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ProductFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"vendorName":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"service":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"productFamily":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"region":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"sku":              &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"attributeFilters": &graphql.InputObjectFieldConfig{Type: graphql.NewList(attributeFilterType)},
+		},
+	})
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"products": &graphql.Field{
+				Type: graphql.NewList(productType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: graphql.NewNonNull(productFilterType)},
+				},
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					arg := params.Args["filter"].(map[string]interface{})
+					filter := &ProductFilter{
+						VendorName:    getString(arg, "vendorName"),
+						Service:       getString(arg, "service"),
+						ProductFamily: getString(arg, "productFamily"),
+						Region:        getString(arg, "region"),
+						SKU:           getString(arg, "sku"),
+					}
+					attributeFilterList, _ := arg["attributeFilters"].([]interface{})
+					for _, item := range attributeFilterList {
+						if attributeFilter, ok := item.(map[string]interface{}); ok {
+							filter.AttributeFilters = append(filter.AttributeFilters, &AttributeFilter{
+								Key:        getString(attributeFilter, "key"),
+								Value:      getString(attributeFilter, "value"),
+								ValueRegex: getString(attributeFilter, "value_regex"),
+							})
+						}
+					}
+					return pricing.FindProduct(filter)
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func newStringField(getValue func(p *Price) string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return nullIfEmpty(getValue(params.Source.(*Price))), nil
+		},
+	}
+}
+
+func newProductStringField(getValue func(p *Product) string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return nullIfEmpty(getValue(params.Source.(*Product))), nil
+		},
+	}
+}
+
+// nullIfEmpty returns nil for the empty string, as the API returns null for the absent fields, e.g. termLength of on-demand prices.
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func getString(arg map[string]interface{}, key string) string {
+	value, _ := arg[key].(string)
+	return value
+}
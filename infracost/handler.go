@@ -0,0 +1,92 @@
+package infracost
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/graphql-go/graphql"
+)
+
+// maxRequestSize is the max size of the request body, Infracost batches the queries of a project in a request.
+const maxRequestSize = 10 << 20
+
+// request is a GraphQL request, Infracost sends a batch of the requests as a JSON array.
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Handler serves the GraphQL pricing API at POST /graphql.
+type Handler struct {
+	schema graphql.Schema
+}
+
+// NewHandler returns the handler serving the products converted from the instances.
+func NewHandler(dbInstanceList []*store.DBInstance) (*Handler, error) {
+	schema, err := NewSchema(NewPricing(NewProductList(dbInstanceList)))
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{schema: schema}, nil
+}
+
+// ServeHTTP executes a single request or a batch of the requests.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var response interface{}
+	if body = bytes.TrimSpace(body); len(body) > 0 && body[0] == '[' {
+		var requestList []*request
+		if err := json.Unmarshal(body, &requestList); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for i, req := range requestList {
+			if req == nil {
+				http.Error(w, fmt.Sprintf("request %d of the batch is null", i), http.StatusBadRequest)
+				return
+			}
+		}
+		var resultList []*graphql.Result
+		for _, req := range requestList {
+			resultList = append(resultList, h.execute(r, req))
+		}
+		response = resultList
+	} else {
+		req := &request{}
+		if err := json.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response = h.execute(r, req)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) execute(r *http.Request, req *request) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	})
+}
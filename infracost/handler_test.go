@@ -0,0 +1,116 @@
+package infracost
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+// productQuery is the query sent by Infracost, see https://github.com/infracost/infracost/blob/master/internal/apiclient/pricing.go
+const productQuery = `query($productFilter: ProductFilter!, $priceFilter: PriceFilter) {
+  products(filter: $productFilter) {
+    productHash
+    attributes { key value }
+    prices(filter: $priceFilter) { priceHash USD unit termLength }
+  }
+}`
+
+type response struct {
+	Data struct {
+		Products []struct {
+			ProductHash string       `json:"productHash"`
+			Attributes  []*Attribute `json:"attributes"`
+			Prices      []*Price     `json:"prices"`
+		} `json:"products"`
+	} `json:"data"`
+	Errors []interface{} `json:"errors"`
+}
+
+func Test_Handler(t *testing.T) {
+	dbInstanceList, err := store.Load("../data/sample.json")
+	require.NoError(t, err)
+	handler, err := NewHandler(dbInstanceList)
+	require.NoError(t, err)
+
+	post := func(body interface{}) *httptest.ResponseRecorder {
+		dataByted, err := json.Marshal(body)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(dataByted))))
+		return recorder
+	}
+
+	// the RDS on-demand query of a Multi-AZ instance, batched with the reserved query.
+	rdsFilter := map[string]interface{}{
+		"vendorName":    "aws",
+		"service":       "AmazonRDS",
+		"productFamily": "Database Instance",
+		"region":        "us-east-1",
+		"attributeFilters": []map[string]interface{}{
+			{"key": "instanceType", "value": "db.r6g.4xlarge"},
+			{"key": "databaseEngine", "value_regex": "/postgresql/i"},
+			{"key": "deploymentOption", "value": "Multi-AZ"},
+		},
+	}
+	recorder := post([]map[string]interface{}{
+		{"query": productQuery, "variables": map[string]interface{}{
+			"productFilter": rdsFilter,
+			"priceFilter":   map[string]interface{}{"purchaseOption": "on_demand"},
+		}},
+		{"query": productQuery, "variables": map[string]interface{}{
+			"productFilter": rdsFilter,
+			"priceFilter":   map[string]interface{}{"purchaseOption": "reserved", "termLength": "1yr", "termPurchaseOption": "Partial Upfront"},
+		}},
+	})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var responseList []*response
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &responseList))
+	require.Len(t, responseList, 2)
+
+	onDemand := responseList[0]
+	require.Empty(t, onDemand.Errors)
+	require.Len(t, onDemand.Data.Products, 1)
+	require.Len(t, onDemand.Data.Products[0].Prices, 1)
+	// the Multi-AZ price is twice the Single-AZ one.
+	require.Equal(t, "4.3160000000", onDemand.Data.Products[0].Prices[0].USD)
+	require.Equal(t, "Hrs", onDemand.Data.Products[0].Prices[0].Unit)
+
+	// the partial upfront term has both the hourly and the upfront prices.
+	reserved := responseList[1]
+	require.Empty(t, reserved.Errors)
+	require.Len(t, reserved.Data.Products[0].Prices, 2)
+	for _, price := range reserved.Data.Products[0].Prices {
+		require.Equal(t, "1yr", price.TermLength)
+	}
+
+	// the Cloud SQL query matching the description.
+	recorder = post(map[string]interface{}{"query": productQuery, "variables": map[string]interface{}{
+		"productFilter": map[string]interface{}{
+			"vendorName": "gcp",
+			"service":    "Cloud SQL",
+			"region":     "us-east4",
+			"attributeFilters": []map[string]interface{}{
+				{"key": "description", "value_regex": "/^Cloud SQL for MySQL: Zonal - 96 vCPU \\+ 360GB RAM$/"},
+			},
+		},
+	}})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	gcp := &response{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), gcp))
+	require.Empty(t, gcp.Errors)
+	require.Len(t, gcp.Data.Products, 1)
+	require.Equal(t, "10.1580000000", gcp.Data.Products[0].Prices[0].USD)
+
+	// a null request in the batch is rejected rather than executed.
+	recorder = post([]interface{}{nil})
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,216 @@
+// Package infracost serves the dbcost data with the subset of the Infracost pricing GraphQL API used for RDS and Cloud SQL,
+// so that Infracost can be pointed to a self-hosted pricing API without external calls.
+package infracost
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/store"
+)
+
+const (
+	// purchaseOptionOnDemand and purchaseOptionReserved are the purchase options used by Infracost.
+	purchaseOptionOnDemand = "on_demand"
+	purchaseOptionReserved = "reserved"
+
+	// unitHour is the unit of the hourly prices, and unitQuantity is the unit of the upfront prices.
+	unitHour     = "Hrs"
+	unitQuantity = "Quantity"
+)
+
+// Attribute is an attribute of a product, e.g. instanceType.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Price is a price of a product, the USD is a decimal string as Infracost expects.
+type Price struct {
+	PriceHash          string `json:"priceHash"`
+	PurchaseOption     string `json:"purchaseOption"`
+	Unit               string `json:"unit"`
+	USD                string `json:"USD"`
+	Description        string `json:"description"`
+	TermLength         string `json:"termLength"`
+	TermPurchaseOption string `json:"termPurchaseOption"`
+	TermOfferingClass  string `json:"termOfferingClass"`
+}
+
+// Product is a priced product, which is an instance of an engine in a region with a deployment option.
+type Product struct {
+	ProductHash   string       `json:"productHash"`
+	SKU           string       `json:"sku"`
+	VendorName    string       `json:"vendorName"`
+	Region        string       `json:"region"`
+	Service       string       `json:"service"`
+	ProductFamily string       `json:"productFamily"`
+	AttributeList []*Attribute `json:"attributes"`
+	PriceList     []*Price     `json:"prices"`
+}
+
+// GetAttribute returns the value of the attribute, empty if not found.
+func (p *Product) GetAttribute(key string) string {
+	for _, attribute := range p.AttributeList {
+		if attribute.Key == key {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
+// deployment is a deployment option of the instance, the standby of a high available deployment is charged the same as the primary.
+type deployment struct {
+	// name is the deployment option of AWS, or the availability type of GCP.
+	name   string
+	factor float64
+}
+
+var (
+	awsDeploymentList = []*deployment{{name: "Single-AZ", factor: 1}, {name: "Multi-AZ", factor: cost.HighAvailabilityFactor}}
+	gcpDeploymentList = []*deployment{{name: "Zonal", factor: 1}, {name: "Regional", factor: cost.HighAvailabilityFactor}}
+)
+
+// engineNameMap is the engine name used by the providers.
+var engineNameMap = map[client.EngineType]string{
+	client.EngineTypeMySQL:      "MySQL",
+	client.EngineTypePostgreSQL: "PostgreSQL",
+}
+
+// NewProductList converts the instances to the products queried by Infracost, archived instances and terms are excluded.
+//   - AWS products are of vendor aws, service AmazonRDS and product family Database Instance,
+//     with the attributes instanceType, databaseEngine and deploymentOption.
+//   - GCP products are of vendor gcp, service Cloud SQL and product family ApplicationServices,
+//     with the attributes resourceGroup and description, e.g. Cloud SQL for PostgreSQL: Zonal - 4 vCPU + 15GB RAM.
+//
+// The dataset only holds the single-zone prices, so the high available products are priced twice the single-zone ones.
+func NewProductList(dbInstanceList []*store.DBInstance) []*Product {
+	var productList []*Product
+	for _, dbInstance := range dbInstanceList {
+		if dbInstance.IsArchived() {
+			continue
+		}
+		deploymentList := awsDeploymentList
+		if dbInstance.CloudProvider == store.CloudProviderGCP {
+			deploymentList = gcpDeploymentList
+		}
+		for _, region := range dbInstance.RegionList {
+			// the terms are grouped by the engine, keeping the order of the engines appearing.
+			var engineList []client.EngineType
+			termMap := make(map[client.EngineType][]*store.Term)
+			for _, term := range region.TermList {
+				if term.IsArchived() {
+					continue
+				}
+				if _, ok := termMap[term.DatabaseEngine]; !ok {
+					engineList = append(engineList, term.DatabaseEngine)
+				}
+				termMap[term.DatabaseEngine] = append(termMap[term.DatabaseEngine], term)
+			}
+			for _, engine := range engineList {
+				for _, d := range deploymentList {
+					if product := newProduct(dbInstance, region, engine, d, termMap[engine]); product != nil {
+						productList = append(productList, product)
+					}
+				}
+			}
+		}
+	}
+	return productList
+}
+
+func newProduct(dbInstance *store.DBInstance, region *store.Region, engine client.EngineType, d *deployment, termList []*store.Term) *Product {
+	engineName, ok := engineNameMap[engine]
+	if !ok {
+		return nil
+	}
+	sku := fmt.Sprintf("%s/%s/%s/%s", dbInstance.GetExternalID(), region.Code, engine, d.name)
+	product := &Product{
+		ProductHash: getHash(sku),
+		SKU:         sku,
+		Region:      region.Code,
+	}
+	var description string
+	switch dbInstance.CloudProvider {
+	case store.CloudProviderAWS:
+		product.VendorName = "aws"
+		product.Service = "AmazonRDS"
+		product.ProductFamily = "Database Instance"
+		product.AttributeList = []*Attribute{
+			{Key: "instanceType", Value: dbInstance.Name},
+			{Key: "databaseEngine", Value: engineName},
+			{Key: "deploymentOption", Value: d.name},
+		}
+	case store.CloudProviderGCP:
+		product.VendorName = "gcp"
+		product.Service = "Cloud SQL"
+		product.ProductFamily = "ApplicationServices"
+		// the same as the description of the SKU, see getCPUMemory in the GCP client.
+		description = fmt.Sprintf("Cloud SQL for %s: %s - %d vCPU + %sGB RAM", engineName, d.name, dbInstance.CPU, dbInstance.Memory)
+		product.AttributeList = []*Attribute{
+			{Key: "resourceGroup", Value: "SQLGen2Instances" + dbInstance.Series},
+			{Key: "description", Value: description},
+			{Key: "databaseEngine", Value: engineName},
+			{Key: "availabilityType", Value: strings.ToUpper(d.name)},
+		}
+	default:
+		return nil
+	}
+	product.AttributeList = append(product.AttributeList,
+		&Attribute{Key: "vcpu", Value: strconv.Itoa(dbInstance.CPU)},
+		&Attribute{Key: "memory", Value: dbInstance.Memory + " GiB"},
+		&Attribute{Key: "regionCode", Value: region.Code},
+	)
+	if region.Name != "" {
+		product.AttributeList = append(product.AttributeList, &Attribute{Key: "location", Value: region.Name})
+	}
+
+	for _, term := range termList {
+		product.PriceList = append(product.PriceList, newPriceList(product, term, d, description)...)
+	}
+	return product
+}
+
+// newPriceList returns the hourly price of the term, and the upfront price if the term has the commitment.
+func newPriceList(product *Product, term *store.Term, d *deployment, description string) []*Price {
+	hourly := &Price{
+		PurchaseOption: purchaseOptionOnDemand,
+		Unit:           unitHour,
+		USD:            formatUSD(term.HourlyUSD * d.factor),
+		Description:    description,
+	}
+	if term.Type != client.ChargeTypeReserved || term.Payload == nil {
+		hourly.PriceHash = getHash(product.ProductHash, term.Code, hourly.Unit)
+		return []*Price{hourly}
+	}
+
+	hourly.PurchaseOption = purchaseOptionReserved
+	hourly.TermLength = term.Payload.LeaseContractLength
+	hourly.TermPurchaseOption = term.Payload.PurchaseOption
+	hourly.TermOfferingClass = "standard"
+	hourly.PriceHash = getHash(product.ProductHash, term.Code, hourly.Unit)
+	priceList := []*Price{hourly}
+	if term.CommitmentUSD > 0 {
+		upfront := *hourly
+		upfront.Unit = unitQuantity
+		upfront.USD = formatUSD(term.CommitmentUSD * d.factor)
+		upfront.PriceHash = getHash(product.ProductHash, term.Code, upfront.Unit)
+		priceList = append(priceList, &upfront)
+	}
+	return priceList
+}
+
+// formatUSD formats the price with 10 decimals, the same as the Infracost pricing API.
+func formatUSD(usd float64) string {
+	return strconv.FormatFloat(usd, 'f', 10, 64)
+}
+
+func getHash(keyList ...string) string {
+	checksum := md5.Sum([]byte(strings.Join(keyList, "-")))
+	return hex.EncodeToString(checksum[:])
+}
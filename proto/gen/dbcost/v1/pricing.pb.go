@@ -0,0 +1,2639 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: dbcost/v1/pricing.proto
+
+package dbcostv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DatabaseEngine is the database engine of a term.
+type DatabaseEngine int32
+
+const (
+	DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED DatabaseEngine = 0
+	DatabaseEngine_DATABASE_ENGINE_MYSQL       DatabaseEngine = 1
+	DatabaseEngine_DATABASE_ENGINE_POSTGRES    DatabaseEngine = 2
+)
+
+// Enum value maps for DatabaseEngine.
+var (
+	DatabaseEngine_name = map[int32]string{
+		0: "DATABASE_ENGINE_UNSPECIFIED",
+		1: "DATABASE_ENGINE_MYSQL",
+		2: "DATABASE_ENGINE_POSTGRES",
+	}
+	DatabaseEngine_value = map[string]int32{
+		"DATABASE_ENGINE_UNSPECIFIED": 0,
+		"DATABASE_ENGINE_MYSQL":       1,
+		"DATABASE_ENGINE_POSTGRES":    2,
+	}
+)
+
+func (x DatabaseEngine) Enum() *DatabaseEngine {
+	p := new(DatabaseEngine)
+	*p = x
+	return p
+}
+
+func (x DatabaseEngine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseEngine) Descriptor() protoreflect.EnumDescriptor {
+	return file_dbcost_v1_pricing_proto_enumTypes[0].Descriptor()
+}
+
+func (DatabaseEngine) Type() protoreflect.EnumType {
+	return &file_dbcost_v1_pricing_proto_enumTypes[0]
+}
+
+func (x DatabaseEngine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseEngine.Descriptor instead.
+func (DatabaseEngine) EnumDescriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+// ChargeType is the charge type of a term.
+type ChargeType int32
+
+const (
+	ChargeType_CHARGE_TYPE_UNSPECIFIED ChargeType = 0
+	ChargeType_CHARGE_TYPE_ON_DEMAND   ChargeType = 1
+	ChargeType_CHARGE_TYPE_RESERVED    ChargeType = 2
+)
+
+// Enum value maps for ChargeType.
+var (
+	ChargeType_name = map[int32]string{
+		0: "CHARGE_TYPE_UNSPECIFIED",
+		1: "CHARGE_TYPE_ON_DEMAND",
+		2: "CHARGE_TYPE_RESERVED",
+	}
+	ChargeType_value = map[string]int32{
+		"CHARGE_TYPE_UNSPECIFIED": 0,
+		"CHARGE_TYPE_ON_DEMAND":   1,
+		"CHARGE_TYPE_RESERVED":    2,
+	}
+)
+
+func (x ChargeType) Enum() *ChargeType {
+	p := new(ChargeType)
+	*p = x
+	return p
+}
+
+func (x ChargeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChargeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dbcost_v1_pricing_proto_enumTypes[1].Descriptor()
+}
+
+func (ChargeType) Type() protoreflect.EnumType {
+	return &file_dbcost_v1_pricing_proto_enumTypes[1]
+}
+
+func (x ChargeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChargeType.Descriptor instead.
+func (ChargeType) EnumDescriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+// SortField is the field to sort the instances by.
+type SortField int32
+
+const (
+	// SORT_FIELD_UNSPECIFIED keeps the order of the catalog.
+	SortField_SORT_FIELD_UNSPECIFIED SortField = 0
+	SortField_SORT_FIELD_HOURLY      SortField = 1
+	// SORT_FIELD_EFFECTIVE sorts by the hourly price with the commitment amortized.
+	SortField_SORT_FIELD_EFFECTIVE         SortField = 2
+	SortField_SORT_FIELD_CPU_HOUR          SortField = 3
+	SortField_SORT_FIELD_GIB_HOUR          SortField = 4
+	SortField_SORT_FIELD_PRICE_PERFORMANCE SortField = 5
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "SORT_FIELD_HOURLY",
+		2: "SORT_FIELD_EFFECTIVE",
+		3: "SORT_FIELD_CPU_HOUR",
+		4: "SORT_FIELD_GIB_HOUR",
+		5: "SORT_FIELD_PRICE_PERFORMANCE",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED":       0,
+		"SORT_FIELD_HOURLY":            1,
+		"SORT_FIELD_EFFECTIVE":         2,
+		"SORT_FIELD_CPU_HOUR":          3,
+		"SORT_FIELD_GIB_HOUR":          4,
+		"SORT_FIELD_PRICE_PERFORMANCE": 5,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_dbcost_v1_pricing_proto_enumTypes[2].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_dbcost_v1_pricing_proto_enumTypes[2]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+// TermPayload is the lease of a reserved term.
+type TermPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. 1yr, 3yr
+	LeaseContractLength string `protobuf:"bytes,1,opt,name=lease_contract_length,json=leaseContractLength,proto3" json:"lease_contract_length,omitempty"`
+	// e.g. No Upfront, All Upfront
+	PurchaseOption string `protobuf:"bytes,2,opt,name=purchase_option,json=purchaseOption,proto3" json:"purchase_option,omitempty"`
+}
+
+func (x *TermPayload) Reset() {
+	*x = TermPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermPayload) ProtoMessage() {}
+
+func (x *TermPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermPayload.ProtoReflect.Descriptor instead.
+func (*TermPayload) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TermPayload) GetLeaseContractLength() string {
+	if x != nil {
+		return x.LeaseContractLength
+	}
+	return ""
+}
+
+func (x *TermPayload) GetPurchaseOption() string {
+	if x != nil {
+		return x.PurchaseOption
+	}
+	return ""
+}
+
+// Term is a pricing term of an instance in a region, mirroring store.Term.
+type Term struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// NORMAL or ARCHIVED.
+	RowStatus      string         `protobuf:"bytes,2,opt,name=row_status,json=rowStatus,proto3" json:"row_status,omitempty"`
+	UpdatedTs      int64          `protobuf:"varint,3,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	ArchivedTs     int64          `protobuf:"varint,4,opt,name=archived_ts,json=archivedTs,proto3" json:"archived_ts,omitempty"`
+	DatabaseEngine DatabaseEngine `protobuf:"varint,5,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	Type           ChargeType     `protobuf:"varint,6,opt,name=type,proto3,enum=dbcost.v1.ChargeType" json:"type,omitempty"`
+	// payload is set for the reserved terms.
+	Payload               *TermPayload `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	HourlyUsd             float64      `protobuf:"fixed64,8,opt,name=hourly_usd,json=hourlyUsd,proto3" json:"hourly_usd,omitempty"`
+	CommitmentUsd         float64      `protobuf:"fixed64,9,opt,name=commitment_usd,json=commitmentUsd,proto3" json:"commitment_usd,omitempty"`
+	UsdPerCpuHour         float64      `protobuf:"fixed64,10,opt,name=usd_per_cpu_hour,json=usdPerCpuHour,proto3" json:"usd_per_cpu_hour,omitempty"`
+	UsdPerGibHour         float64      `protobuf:"fixed64,11,opt,name=usd_per_gib_hour,json=usdPerGibHour,proto3" json:"usd_per_gib_hour,omitempty"`
+	PricePerformanceIndex float64      `protobuf:"fixed64,12,opt,name=price_performance_index,json=pricePerformanceIndex,proto3" json:"price_performance_index,omitempty"`
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Term) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Term) GetRowStatus() string {
+	if x != nil {
+		return x.RowStatus
+	}
+	return ""
+}
+
+func (x *Term) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+func (x *Term) GetArchivedTs() int64 {
+	if x != nil {
+		return x.ArchivedTs
+	}
+	return 0
+}
+
+func (x *Term) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *Term) GetType() ChargeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChargeType_CHARGE_TYPE_UNSPECIFIED
+}
+
+func (x *Term) GetPayload() *TermPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Term) GetHourlyUsd() float64 {
+	if x != nil {
+		return x.HourlyUsd
+	}
+	return 0
+}
+
+func (x *Term) GetCommitmentUsd() float64 {
+	if x != nil {
+		return x.CommitmentUsd
+	}
+	return 0
+}
+
+func (x *Term) GetUsdPerCpuHour() float64 {
+	if x != nil {
+		return x.UsdPerCpuHour
+	}
+	return 0
+}
+
+func (x *Term) GetUsdPerGibHour() float64 {
+	if x != nil {
+		return x.UsdPerGibHour
+	}
+	return 0
+}
+
+func (x *Term) GetPricePerformanceIndex() float64 {
+	if x != nil {
+		return x.PricePerformanceIndex
+	}
+	return 0
+}
+
+// Region is the prices of an instance in a region, mirroring store.Region.
+type Region struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the region code used by the provider, e.g. us-east-1.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// slug is the canonical region, e.g. europe-frankfurt, empty if the region is not in the catalog.
+	Slug      string  `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name      string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Geography string  `protobuf:"bytes,4,opt,name=geography,proto3" json:"geography,omitempty"`
+	Continent string  `protobuf:"bytes,5,opt,name=continent,proto3" json:"continent,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Terms     []*Term `protobuf:"bytes,8,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Region) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Region) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Region) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Region) GetGeography() string {
+	if x != nil {
+		return x.Geography
+	}
+	return ""
+}
+
+func (x *Region) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+func (x *Region) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Region) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Region) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// Equivalent is the nearest equivalent of an instance on another provider.
+type Equivalent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalId string  `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Score      float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Equivalent) Reset() {
+	*x = Equivalent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Equivalent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Equivalent) ProtoMessage() {}
+
+func (x *Equivalent) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Equivalent.ProtoReflect.Descriptor instead.
+func (*Equivalent) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Equivalent) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Equivalent) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// DBInstance is a database instance type, mirroring store.DBInstance.
+type DBInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// external_id is derived from the provider and the name, e.g. AWS:db.r6g.xlarge.
+	ExternalId    string    `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	RowStatus     string    `protobuf:"bytes,3,opt,name=row_status,json=rowStatus,proto3" json:"row_status,omitempty"`
+	CreatorId     int64     `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	UpdaterId     int64     `protobuf:"varint,5,opt,name=updater_id,json=updaterId,proto3" json:"updater_id,omitempty"`
+	UpdatedTs     int64     `protobuf:"varint,6,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	ArchivedTs    int64     `protobuf:"varint,7,opt,name=archived_ts,json=archivedTs,proto3" json:"archived_ts,omitempty"`
+	Regions       []*Region `protobuf:"bytes,8,rep,name=regions,proto3" json:"regions,omitempty"`
+	CloudProvider string    `protobuf:"bytes,9,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Name          string    `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu           int32     `protobuf:"varint,11,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// memory is the memory in GiB as a decimal string, e.g. 15.25.
+	Memory       string        `protobuf:"bytes,12,opt,name=memory,proto3" json:"memory,omitempty"`
+	Processor    string        `protobuf:"bytes,13,opt,name=processor,proto3" json:"processor,omitempty"`
+	Family       string        `protobuf:"bytes,14,opt,name=family,proto3" json:"family,omitempty"`
+	Series       string        `protobuf:"bytes,15,opt,name=series,proto3" json:"series,omitempty"`
+	Size         string        `protobuf:"bytes,16,opt,name=size,proto3" json:"size,omitempty"`
+	SizeRank     int32         `protobuf:"varint,17,opt,name=size_rank,json=sizeRank,proto3" json:"size_rank,omitempty"`
+	Architecture string        `protobuf:"bytes,18,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Equivalents  []*Equivalent `protobuf:"bytes,19,rep,name=equivalents,proto3" json:"equivalents,omitempty"`
+}
+
+func (x *DBInstance) Reset() {
+	*x = DBInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBInstance) ProtoMessage() {}
+
+func (x *DBInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBInstance.ProtoReflect.Descriptor instead.
+func (*DBInstance) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DBInstance) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DBInstance) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *DBInstance) GetRowStatus() string {
+	if x != nil {
+		return x.RowStatus
+	}
+	return ""
+}
+
+func (x *DBInstance) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *DBInstance) GetUpdaterId() int64 {
+	if x != nil {
+		return x.UpdaterId
+	}
+	return 0
+}
+
+func (x *DBInstance) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+func (x *DBInstance) GetArchivedTs() int64 {
+	if x != nil {
+		return x.ArchivedTs
+	}
+	return 0
+}
+
+func (x *DBInstance) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *DBInstance) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *DBInstance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBInstance) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *DBInstance) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *DBInstance) GetProcessor() string {
+	if x != nil {
+		return x.Processor
+	}
+	return ""
+}
+
+func (x *DBInstance) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *DBInstance) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *DBInstance) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *DBInstance) GetSizeRank() int32 {
+	if x != nil {
+		return x.SizeRank
+	}
+	return 0
+}
+
+func (x *DBInstance) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *DBInstance) GetEquivalents() []*Equivalent {
+	if x != nil {
+		return x.Equivalents
+	}
+	return nil
+}
+
+// ListInstancesRequest is the query of the instances, empty fields match all.
+type ListInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cloud_provider is case-insensitive, e.g. aws.
+	CloudProvider  string         `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	DatabaseEngine DatabaseEngine `protobuf:"varint,2,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	// region is either the region code of the provider or the canonical region slug.
+	Region              string     `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	ChargeType          ChargeType `protobuf:"varint,4,opt,name=charge_type,json=chargeType,proto3,enum=dbcost.v1.ChargeType" json:"charge_type,omitempty"`
+	LeaseContractLength string     `protobuf:"bytes,5,opt,name=lease_contract_length,json=leaseContractLength,proto3" json:"lease_contract_length,omitempty"`
+	PurchaseOption      string     `protobuf:"bytes,6,opt,name=purchase_option,json=purchaseOption,proto3" json:"purchase_option,omitempty"`
+	MinCpu              int32      `protobuf:"varint,7,opt,name=min_cpu,json=minCpu,proto3" json:"min_cpu,omitempty"`
+	// min_memory is in GiB.
+	MinMemory float64 `protobuf:"fixed64,8,opt,name=min_memory,json=minMemory,proto3" json:"min_memory,omitempty"`
+	// processor matches the processor case-insensitively, e.g. graviton.
+	Processor string `protobuf:"bytes,9,opt,name=processor,proto3" json:"processor,omitempty"`
+	// family is the taxonomy family, e.g. MEMORY_OPTIMIZED.
+	Family string `protobuf:"bytes,10,opt,name=family,proto3" json:"family,omitempty"`
+	// architecture is X86_64 or ARM64.
+	Architecture             string  `protobuf:"bytes,11,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	MaxUsdPerCpuHour         float64 `protobuf:"fixed64,12,opt,name=max_usd_per_cpu_hour,json=maxUsdPerCpuHour,proto3" json:"max_usd_per_cpu_hour,omitempty"`
+	MaxUsdPerGibHour         float64 `protobuf:"fixed64,13,opt,name=max_usd_per_gib_hour,json=maxUsdPerGibHour,proto3" json:"max_usd_per_gib_hour,omitempty"`
+	MaxPricePerformanceIndex float64 `protobuf:"fixed64,14,opt,name=max_price_performance_index,json=maxPricePerformanceIndex,proto3" json:"max_price_performance_index,omitempty"`
+	IncludeArchived          bool    `protobuf:"varint,15,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// sort_by sorts the instances by their first matching term.
+	SortBy     SortField `protobuf:"varint,16,opt,name=sort_by,json=sortBy,proto3,enum=dbcost.v1.SortField" json:"sort_by,omitempty"`
+	Descending bool      `protobuf:"varint,17,opt,name=descending,proto3" json:"descending,omitempty"`
+	Offset     int32     `protobuf:"varint,18,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is the max count of the instances streamed, 0 streams all.
+	Limit int32 `protobuf:"varint,19,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListInstancesRequest) Reset() {
+	*x = ListInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstancesRequest) ProtoMessage() {}
+
+func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListInstancesRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *ListInstancesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetChargeType() ChargeType {
+	if x != nil {
+		return x.ChargeType
+	}
+	return ChargeType_CHARGE_TYPE_UNSPECIFIED
+}
+
+func (x *ListInstancesRequest) GetLeaseContractLength() string {
+	if x != nil {
+		return x.LeaseContractLength
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetPurchaseOption() string {
+	if x != nil {
+		return x.PurchaseOption
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetMinCpu() int32 {
+	if x != nil {
+		return x.MinCpu
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetMinMemory() float64 {
+	if x != nil {
+		return x.MinMemory
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetProcessor() string {
+	if x != nil {
+		return x.Processor
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetMaxUsdPerCpuHour() float64 {
+	if x != nil {
+		return x.MaxUsdPerCpuHour
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetMaxUsdPerGibHour() float64 {
+	if x != nil {
+		return x.MaxUsdPerGibHour
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetMaxPricePerformanceIndex() float64 {
+	if x != nil {
+		return x.MaxPricePerformanceIndex
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListInstancesRequest) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListInstancesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListInstancesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListInstancesResponse is an instance streamed, pruned to the matching regions and terms.
+type ListInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbInstance *DBInstance `protobuf:"bytes,1,opt,name=db_instance,json=dbInstance,proto3" json:"db_instance,omitempty"`
+}
+
+func (x *ListInstancesResponse) Reset() {
+	*x = ListInstancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstancesResponse) ProtoMessage() {}
+
+func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListInstancesResponse) GetDbInstance() *DBInstance {
+	if x != nil {
+		return x.DbInstance
+	}
+	return nil
+}
+
+type GetInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// external_id is the external ID of the instance, e.g. AWS:db.r6g.xlarge.
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (x *GetInstanceRequest) Reset() {
+	*x = GetInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceRequest) ProtoMessage() {}
+
+func (x *GetInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetInstanceRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type GetInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbInstance *DBInstance `protobuf:"bytes,1,opt,name=db_instance,json=dbInstance,proto3" json:"db_instance,omitempty"`
+}
+
+func (x *GetInstanceResponse) Reset() {
+	*x = GetInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceResponse) ProtoMessage() {}
+
+func (x *GetInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceResponse.ProtoReflect.Descriptor instead.
+func (*GetInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetInstanceResponse) GetDbInstance() *DBInstance {
+	if x != nil {
+		return x.DbInstance
+	}
+	return nil
+}
+
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cloud_provider is case-insensitive, e.g. aws.
+	CloudProvider string `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	// continent is case-insensitive, e.g. EUROPE.
+	Continent string `protobuf:"bytes,2,opt,name=continent,proto3" json:"continent,omitempty"`
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRegionsRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *ListRegionsRequest) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+// RegionSummary is a region of a provider with the count of the instances provided in it.
+type RegionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudProvider string  `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Code          string  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Slug          string  `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Geography     string  `protobuf:"bytes,5,opt,name=geography,proto3" json:"geography,omitempty"`
+	Continent     string  `protobuf:"bytes,6,opt,name=continent,proto3" json:"continent,omitempty"`
+	Latitude      float64 `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	InstanceCount int32   `protobuf:"varint,9,opt,name=instance_count,json=instanceCount,proto3" json:"instance_count,omitempty"`
+}
+
+func (x *RegionSummary) Reset() {
+	*x = RegionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionSummary) ProtoMessage() {}
+
+func (x *RegionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionSummary.ProtoReflect.Descriptor instead.
+func (*RegionSummary) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegionSummary) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetGeography() string {
+	if x != nil {
+		return x.Geography
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetContinent() string {
+	if x != nil {
+		return x.Continent
+	}
+	return ""
+}
+
+func (x *RegionSummary) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RegionSummary) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RegionSummary) GetInstanceCount() int32 {
+	if x != nil {
+		return x.InstanceCount
+	}
+	return 0
+}
+
+// ListRegionsResponse is a region streamed.
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region *RegionSummary `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRegionsResponse) GetRegion() *RegionSummary {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+type CompareInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// external_ids are the instances to compare, a single instance is compared with its equivalents on the other providers.
+	ExternalIds    []string       `protobuf:"bytes,1,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
+	DatabaseEngine DatabaseEngine `protobuf:"varint,2,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	// region is either the region code of the provider or the canonical region slug, all regions if empty.
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *CompareInstancesRequest) Reset() {
+	*x = CompareInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareInstancesRequest) ProtoMessage() {}
+
+func (x *CompareInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareInstancesRequest.ProtoReflect.Descriptor instead.
+func (*CompareInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CompareInstancesRequest) GetExternalIds() []string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *CompareInstancesRequest) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *CompareInstancesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// ComparePrice is the cheapest on-demand price of an instance in a region.
+type ComparePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code          string  `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Slug          string  `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TermCode      string  `protobuf:"bytes,4,opt,name=term_code,json=termCode,proto3" json:"term_code,omitempty"`
+	HourlyUsd     float64 `protobuf:"fixed64,5,opt,name=hourly_usd,json=hourlyUsd,proto3" json:"hourly_usd,omitempty"`
+	MonthlyUsd    float64 `protobuf:"fixed64,6,opt,name=monthly_usd,json=monthlyUsd,proto3" json:"monthly_usd,omitempty"`
+	UsdPerCpuHour float64 `protobuf:"fixed64,7,opt,name=usd_per_cpu_hour,json=usdPerCpuHour,proto3" json:"usd_per_cpu_hour,omitempty"`
+	UsdPerGibHour float64 `protobuf:"fixed64,8,opt,name=usd_per_gib_hour,json=usdPerGibHour,proto3" json:"usd_per_gib_hour,omitempty"`
+}
+
+func (x *ComparePrice) Reset() {
+	*x = ComparePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePrice) ProtoMessage() {}
+
+func (x *ComparePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePrice.ProtoReflect.Descriptor instead.
+func (*ComparePrice) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ComparePrice) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ComparePrice) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ComparePrice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparePrice) GetTermCode() string {
+	if x != nil {
+		return x.TermCode
+	}
+	return ""
+}
+
+func (x *ComparePrice) GetHourlyUsd() float64 {
+	if x != nil {
+		return x.HourlyUsd
+	}
+	return 0
+}
+
+func (x *ComparePrice) GetMonthlyUsd() float64 {
+	if x != nil {
+		return x.MonthlyUsd
+	}
+	return 0
+}
+
+func (x *ComparePrice) GetUsdPerCpuHour() float64 {
+	if x != nil {
+		return x.UsdPerCpuHour
+	}
+	return 0
+}
+
+func (x *ComparePrice) GetUsdPerGibHour() float64 {
+	if x != nil {
+		return x.UsdPerGibHour
+	}
+	return 0
+}
+
+// CompareItem is an instance compared, the regions are excluded from the instance and listed as the prices.
+type CompareItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbInstance *DBInstance     `protobuf:"bytes,1,opt,name=db_instance,json=dbInstance,proto3" json:"db_instance,omitempty"`
+	Prices     []*ComparePrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	// cheapest is unset if the instance is not provided in any region matched.
+	Cheapest *ComparePrice `protobuf:"bytes,3,opt,name=cheapest,proto3" json:"cheapest,omitempty"`
+}
+
+func (x *CompareItem) Reset() {
+	*x = CompareItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareItem) ProtoMessage() {}
+
+func (x *CompareItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareItem.ProtoReflect.Descriptor instead.
+func (*CompareItem) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompareItem) GetDbInstance() *DBInstance {
+	if x != nil {
+		return x.DbInstance
+	}
+	return nil
+}
+
+func (x *CompareItem) GetPrices() []*ComparePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *CompareItem) GetCheapest() *ComparePrice {
+	if x != nil {
+		return x.Cheapest
+	}
+	return nil
+}
+
+type CompareInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseEngine DatabaseEngine `protobuf:"varint,1,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	Items          []*CompareItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CompareInstancesResponse) Reset() {
+	*x = CompareInstancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareInstancesResponse) ProtoMessage() {}
+
+func (x *CompareInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareInstancesResponse.ProtoReflect.Descriptor instead.
+func (*CompareInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompareInstancesResponse) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *CompareInstancesResponse) GetItems() []*CompareItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type EstimateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// region_code is the region code of the provider, e.g. us-east-1.
+	RegionCode     string         `protobuf:"bytes,2,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	DatabaseEngine DatabaseEngine `protobuf:"varint,3,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	// months is the horizon, 12 if unset.
+	Months float64 `protobuf:"fixed64,4,opt,name=months,proto3" json:"months,omitempty"`
+	// utilization is the ratio of the horizon the instance is running, 1 if unset.
+	Utilization *float64 `protobuf:"fixed64,5,opt,name=utilization,proto3,oneof" json:"utilization,omitempty"`
+}
+
+func (x *EstimateRequest) Reset() {
+	*x = EstimateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateRequest) ProtoMessage() {}
+
+func (x *EstimateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateRequest.ProtoReflect.Descriptor instead.
+func (*EstimateRequest) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EstimateRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *EstimateRequest) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *EstimateRequest) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *EstimateRequest) GetMonths() float64 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *EstimateRequest) GetUtilization() float64 {
+	if x != nil && x.Utilization != nil {
+		return *x.Utilization
+	}
+	return 0
+}
+
+// CostEstimate is the cost of a term over a horizon, mirroring cost.Estimate.
+type CostEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HorizonHours float64 `protobuf:"fixed64,1,opt,name=horizon_hours,json=horizonHours,proto3" json:"horizon_hours,omitempty"`
+	Utilization  float64 `protobuf:"fixed64,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	LeaseCount   int32   `protobuf:"varint,3,opt,name=lease_count,json=leaseCount,proto3" json:"lease_count,omitempty"`
+	UpfrontUsd   float64 `protobuf:"fixed64,4,opt,name=upfront_usd,json=upfrontUsd,proto3" json:"upfront_usd,omitempty"`
+	RecurringUsd float64 `protobuf:"fixed64,5,opt,name=recurring_usd,json=recurringUsd,proto3" json:"recurring_usd,omitempty"`
+	TotalUsd     float64 `protobuf:"fixed64,6,opt,name=total_usd,json=totalUsd,proto3" json:"total_usd,omitempty"`
+	MonthlyUsd   float64 `protobuf:"fixed64,7,opt,name=monthly_usd,json=monthlyUsd,proto3" json:"monthly_usd,omitempty"`
+}
+
+func (x *CostEstimate) Reset() {
+	*x = CostEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CostEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostEstimate) ProtoMessage() {}
+
+func (x *CostEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostEstimate.ProtoReflect.Descriptor instead.
+func (*CostEstimate) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CostEstimate) GetHorizonHours() float64 {
+	if x != nil {
+		return x.HorizonHours
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetLeaseCount() int32 {
+	if x != nil {
+		return x.LeaseCount
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetUpfrontUsd() float64 {
+	if x != nil {
+		return x.UpfrontUsd
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetRecurringUsd() float64 {
+	if x != nil {
+		return x.RecurringUsd
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetTotalUsd() float64 {
+	if x != nil {
+		return x.TotalUsd
+	}
+	return 0
+}
+
+func (x *CostEstimate) GetMonthlyUsd() float64 {
+	if x != nil {
+		return x.MonthlyUsd
+	}
+	return 0
+}
+
+// TermAnalysis is the cost of a term compared to on-demand.
+type TermAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term          *Term         `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Estimate      *CostEstimate `protobuf:"bytes,2,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	SavingUsd     float64       `protobuf:"fixed64,3,opt,name=saving_usd,json=savingUsd,proto3" json:"saving_usd,omitempty"`
+	SavingPercent float64       `protobuf:"fixed64,4,opt,name=saving_percent,json=savingPercent,proto3" json:"saving_percent,omitempty"`
+	// break_even_month is unset if the term never beats on-demand with the utilization.
+	BreakEvenMonth *float64 `protobuf:"fixed64,5,opt,name=break_even_month,json=breakEvenMonth,proto3,oneof" json:"break_even_month,omitempty"`
+}
+
+func (x *TermAnalysis) Reset() {
+	*x = TermAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermAnalysis) ProtoMessage() {}
+
+func (x *TermAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermAnalysis.ProtoReflect.Descriptor instead.
+func (*TermAnalysis) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TermAnalysis) GetTerm() *Term {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+func (x *TermAnalysis) GetEstimate() *CostEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+func (x *TermAnalysis) GetSavingUsd() float64 {
+	if x != nil {
+		return x.SavingUsd
+	}
+	return 0
+}
+
+func (x *TermAnalysis) GetSavingPercent() float64 {
+	if x != nil {
+		return x.SavingPercent
+	}
+	return 0
+}
+
+func (x *TermAnalysis) GetBreakEvenMonth() float64 {
+	if x != nil && x.BreakEvenMonth != nil {
+		return *x.BreakEvenMonth
+	}
+	return 0
+}
+
+// EstimateResponse is the break-even analysis of the terms, mirroring cost.Analysis.
+type EstimateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatabaseEngine DatabaseEngine `protobuf:"varint,1,opt,name=database_engine,json=databaseEngine,proto3,enum=dbcost.v1.DatabaseEngine" json:"database_engine,omitempty"`
+	HorizonHours   float64        `protobuf:"fixed64,2,opt,name=horizon_hours,json=horizonHours,proto3" json:"horizon_hours,omitempty"`
+	Utilization    float64        `protobuf:"fixed64,3,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	OnDemand       *TermAnalysis  `protobuf:"bytes,4,opt,name=on_demand,json=onDemand,proto3" json:"on_demand,omitempty"`
+	// term_analyses are ordered by the total cost ascending.
+	TermAnalyses []*TermAnalysis `protobuf:"bytes,5,rep,name=term_analyses,json=termAnalyses,proto3" json:"term_analyses,omitempty"`
+	Cheapest     *TermAnalysis   `protobuf:"bytes,6,opt,name=cheapest,proto3" json:"cheapest,omitempty"`
+}
+
+func (x *EstimateResponse) Reset() {
+	*x = EstimateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dbcost_v1_pricing_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateResponse) ProtoMessage() {}
+
+func (x *EstimateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dbcost_v1_pricing_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateResponse.ProtoReflect.Descriptor instead.
+func (*EstimateResponse) Descriptor() ([]byte, []int) {
+	return file_dbcost_v1_pricing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EstimateResponse) GetDatabaseEngine() DatabaseEngine {
+	if x != nil {
+		return x.DatabaseEngine
+	}
+	return DatabaseEngine_DATABASE_ENGINE_UNSPECIFIED
+}
+
+func (x *EstimateResponse) GetHorizonHours() float64 {
+	if x != nil {
+		return x.HorizonHours
+	}
+	return 0
+}
+
+func (x *EstimateResponse) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *EstimateResponse) GetOnDemand() *TermAnalysis {
+	if x != nil {
+		return x.OnDemand
+	}
+	return nil
+}
+
+func (x *EstimateResponse) GetTermAnalyses() []*TermAnalysis {
+	if x != nil {
+		return x.TermAnalyses
+	}
+	return nil
+}
+
+func (x *EstimateResponse) GetCheapest() *TermAnalysis {
+	if x != nil {
+		return x.Cheapest
+	}
+	return nil
+}
+
+var File_dbcost_v1_pricing_proto protoreflect.FileDescriptor
+
+var file_dbcost_v1_pricing_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x64, 0x62, 0x63, 0x6f, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x22, 0x6a, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xea, 0x03, 0x0a, 0x04, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12, 0x42, 0x0a, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64,
+	0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x55, 0x73, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x75, 0x73, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63,
+	0x70, 0x75, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75,
+	0x73, 0x64, 0x50, 0x65, 0x72, 0x43, 0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x27, 0x0a, 0x10,
+	0x75, 0x73, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x62, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x73, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69,
+	0x62, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe1, 0x01,
+	0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x65,
+	0x72, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x62, 0x63, 0x6f,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d,
+	0x73, 0x22, 0x43, 0x0a, 0x0a, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xc8, 0x04, 0x0a, 0x0a, 0x44, 0x42, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x54, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x69, 0x7a, 0x65,
+	0x52, 0x61, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x71, 0x75, 0x69,
+	0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61,
+	0x6c, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x87, 0x06, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x70, 0x75, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x43, 0x70, 0x75, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x64, 0x50, 0x65, 0x72, 0x43, 0x70, 0x75,
+	0x48, 0x6f, 0x75, 0x72, 0x12, 0x2e, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x67, 0x69, 0x62, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x62,
+	0x48, 0x6f, 0x75, 0x72, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2d,
+	0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x62, 0x63, 0x6f,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x0a, 0x64, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x35, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x62,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x42, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x62, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x59, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x8f, 0x02,
+	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x47, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x55, 0x73, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x55, 0x73, 0x64, 0x12, 0x27, 0x0a,
+	0x10, 0x75, 0x73, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x73, 0x64, 0x50, 0x65, 0x72, 0x43,
+	0x70, 0x75, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x75, 0x73, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x67, 0x69, 0x62, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x75, 0x73, 0x64, 0x50, 0x65, 0x72, 0x47, 0x69, 0x62, 0x48, 0x6f, 0x75, 0x72, 0x22,
+	0xab, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x36, 0x0a, 0x0b, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x42, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x64, 0x62, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x61,
+	0x70, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x08, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x01,
+	0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xe6, 0x01, 0x0a,
+	0x0f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x62,
+	0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x25,
+	0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfa, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
+	0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x75, 0x70, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x55, 0x73, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x73,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x75, 0x73, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x55,
+	0x73, 0x64, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x0e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0xc6, 0x02, 0x0a, 0x10, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x3c, 0x0a,
+	0x0d, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x72, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x0c, 0x74,
+	0x65, 0x72, 0x6d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63,
+	0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x08, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74,
+	0x2a, 0x6a, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x45,
+	0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x4e, 0x47, 0x49, 0x4e,
+	0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x5e, 0x0a, 0x0a,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48,
+	0x41, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x52, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4d, 0x41, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xac, 0x01, 0x0a,
+	0x09, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x45, 0x46, 0x46, 0x45,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x50, 0x55, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x03,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x47,
+	0x49, 0x42, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x45,
+	0x52, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x05, 0x32, 0xa6, 0x03, 0x0a, 0x0e,
+	0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x1f, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x08, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x62,
+	0x63, 0x6f, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x64, 0x62, 0x63, 0x6f,
+	0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x64, 0x62, 0x63,
+	0x6f, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x62, 0x63, 0x6f, 0x73, 0x74, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dbcost_v1_pricing_proto_rawDescOnce sync.Once
+	file_dbcost_v1_pricing_proto_rawDescData = file_dbcost_v1_pricing_proto_rawDesc
+)
+
+func file_dbcost_v1_pricing_proto_rawDescGZIP() []byte {
+	file_dbcost_v1_pricing_proto_rawDescOnce.Do(func() {
+		file_dbcost_v1_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(file_dbcost_v1_pricing_proto_rawDescData)
+	})
+	return file_dbcost_v1_pricing_proto_rawDescData
+}
+
+var file_dbcost_v1_pricing_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_dbcost_v1_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_dbcost_v1_pricing_proto_goTypes = []interface{}{
+	(DatabaseEngine)(0),              // 0: dbcost.v1.DatabaseEngine
+	(ChargeType)(0),                  // 1: dbcost.v1.ChargeType
+	(SortField)(0),                   // 2: dbcost.v1.SortField
+	(*TermPayload)(nil),              // 3: dbcost.v1.TermPayload
+	(*Term)(nil),                     // 4: dbcost.v1.Term
+	(*Region)(nil),                   // 5: dbcost.v1.Region
+	(*Equivalent)(nil),               // 6: dbcost.v1.Equivalent
+	(*DBInstance)(nil),               // 7: dbcost.v1.DBInstance
+	(*ListInstancesRequest)(nil),     // 8: dbcost.v1.ListInstancesRequest
+	(*ListInstancesResponse)(nil),    // 9: dbcost.v1.ListInstancesResponse
+	(*GetInstanceRequest)(nil),       // 10: dbcost.v1.GetInstanceRequest
+	(*GetInstanceResponse)(nil),      // 11: dbcost.v1.GetInstanceResponse
+	(*ListRegionsRequest)(nil),       // 12: dbcost.v1.ListRegionsRequest
+	(*RegionSummary)(nil),            // 13: dbcost.v1.RegionSummary
+	(*ListRegionsResponse)(nil),      // 14: dbcost.v1.ListRegionsResponse
+	(*CompareInstancesRequest)(nil),  // 15: dbcost.v1.CompareInstancesRequest
+	(*ComparePrice)(nil),             // 16: dbcost.v1.ComparePrice
+	(*CompareItem)(nil),              // 17: dbcost.v1.CompareItem
+	(*CompareInstancesResponse)(nil), // 18: dbcost.v1.CompareInstancesResponse
+	(*EstimateRequest)(nil),          // 19: dbcost.v1.EstimateRequest
+	(*CostEstimate)(nil),             // 20: dbcost.v1.CostEstimate
+	(*TermAnalysis)(nil),             // 21: dbcost.v1.TermAnalysis
+	(*EstimateResponse)(nil),         // 22: dbcost.v1.EstimateResponse
+}
+var file_dbcost_v1_pricing_proto_depIdxs = []int32{
+	0,  // 0: dbcost.v1.Term.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	1,  // 1: dbcost.v1.Term.type:type_name -> dbcost.v1.ChargeType
+	3,  // 2: dbcost.v1.Term.payload:type_name -> dbcost.v1.TermPayload
+	4,  // 3: dbcost.v1.Region.terms:type_name -> dbcost.v1.Term
+	5,  // 4: dbcost.v1.DBInstance.regions:type_name -> dbcost.v1.Region
+	6,  // 5: dbcost.v1.DBInstance.equivalents:type_name -> dbcost.v1.Equivalent
+	0,  // 6: dbcost.v1.ListInstancesRequest.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	1,  // 7: dbcost.v1.ListInstancesRequest.charge_type:type_name -> dbcost.v1.ChargeType
+	2,  // 8: dbcost.v1.ListInstancesRequest.sort_by:type_name -> dbcost.v1.SortField
+	7,  // 9: dbcost.v1.ListInstancesResponse.db_instance:type_name -> dbcost.v1.DBInstance
+	7,  // 10: dbcost.v1.GetInstanceResponse.db_instance:type_name -> dbcost.v1.DBInstance
+	13, // 11: dbcost.v1.ListRegionsResponse.region:type_name -> dbcost.v1.RegionSummary
+	0,  // 12: dbcost.v1.CompareInstancesRequest.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	7,  // 13: dbcost.v1.CompareItem.db_instance:type_name -> dbcost.v1.DBInstance
+	16, // 14: dbcost.v1.CompareItem.prices:type_name -> dbcost.v1.ComparePrice
+	16, // 15: dbcost.v1.CompareItem.cheapest:type_name -> dbcost.v1.ComparePrice
+	0,  // 16: dbcost.v1.CompareInstancesResponse.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	17, // 17: dbcost.v1.CompareInstancesResponse.items:type_name -> dbcost.v1.CompareItem
+	0,  // 18: dbcost.v1.EstimateRequest.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	4,  // 19: dbcost.v1.TermAnalysis.term:type_name -> dbcost.v1.Term
+	20, // 20: dbcost.v1.TermAnalysis.estimate:type_name -> dbcost.v1.CostEstimate
+	0,  // 21: dbcost.v1.EstimateResponse.database_engine:type_name -> dbcost.v1.DatabaseEngine
+	21, // 22: dbcost.v1.EstimateResponse.on_demand:type_name -> dbcost.v1.TermAnalysis
+	21, // 23: dbcost.v1.EstimateResponse.term_analyses:type_name -> dbcost.v1.TermAnalysis
+	21, // 24: dbcost.v1.EstimateResponse.cheapest:type_name -> dbcost.v1.TermAnalysis
+	8,  // 25: dbcost.v1.PricingService.ListInstances:input_type -> dbcost.v1.ListInstancesRequest
+	10, // 26: dbcost.v1.PricingService.GetInstance:input_type -> dbcost.v1.GetInstanceRequest
+	12, // 27: dbcost.v1.PricingService.ListRegions:input_type -> dbcost.v1.ListRegionsRequest
+	15, // 28: dbcost.v1.PricingService.CompareInstances:input_type -> dbcost.v1.CompareInstancesRequest
+	19, // 29: dbcost.v1.PricingService.Estimate:input_type -> dbcost.v1.EstimateRequest
+	9,  // 30: dbcost.v1.PricingService.ListInstances:output_type -> dbcost.v1.ListInstancesResponse
+	11, // 31: dbcost.v1.PricingService.GetInstance:output_type -> dbcost.v1.GetInstanceResponse
+	14, // 32: dbcost.v1.PricingService.ListRegions:output_type -> dbcost.v1.ListRegionsResponse
+	18, // 33: dbcost.v1.PricingService.CompareInstances:output_type -> dbcost.v1.CompareInstancesResponse
+	22, // 34: dbcost.v1.PricingService.Estimate:output_type -> dbcost.v1.EstimateResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_dbcost_v1_pricing_proto_init() }
+func file_dbcost_v1_pricing_proto_init() {
+	if File_dbcost_v1_pricing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dbcost_v1_pricing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Term); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Region); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Equivalent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareInstancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dbcost_v1_pricing_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_dbcost_v1_pricing_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_dbcost_v1_pricing_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dbcost_v1_pricing_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dbcost_v1_pricing_proto_goTypes,
+		DependencyIndexes: file_dbcost_v1_pricing_proto_depIdxs,
+		EnumInfos:         file_dbcost_v1_pricing_proto_enumTypes,
+		MessageInfos:      file_dbcost_v1_pricing_proto_msgTypes,
+	}.Build()
+	File_dbcost_v1_pricing_proto = out.File
+	file_dbcost_v1_pricing_proto_rawDesc = nil
+	file_dbcost_v1_pricing_proto_goTypes = nil
+	file_dbcost_v1_pricing_proto_depIdxs = nil
+}
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: dbcost/v1/pricing.proto
+
+package dbcostv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PricingService_ListInstances_FullMethodName    = "/dbcost.v1.PricingService/ListInstances"
+	PricingService_GetInstance_FullMethodName      = "/dbcost.v1.PricingService/GetInstance"
+	PricingService_ListRegions_FullMethodName      = "/dbcost.v1.PricingService/ListRegions"
+	PricingService_CompareInstances_FullMethodName = "/dbcost.v1.PricingService/CompareInstances"
+	PricingService_Estimate_FullMethodName         = "/dbcost.v1.PricingService/Estimate"
+)
+
+// PricingServiceClient is the client API for PricingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PricingServiceClient interface {
+	// ListInstances streams the instances with the regions and terms matching the request.
+	// The count of the matched instances before pagination is sent in the x-total-count header.
+	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (PricingService_ListInstancesClient, error)
+	// GetInstance returns the instance with all its regions and terms.
+	GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*GetInstanceResponse, error)
+	// ListRegions streams the regions of the providers in the catalog, ordered by the provider and the code.
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (PricingService_ListRegionsClient, error)
+	// CompareInstances compares the specs and the cheapest on-demand prices of the instances side by side.
+	CompareInstances(ctx context.Context, in *CompareInstancesRequest, opts ...grpc.CallOption) (*CompareInstancesResponse, error)
+	// Estimate estimates the cost of the terms of an instance in a region over the horizon.
+	Estimate(ctx context.Context, in *EstimateRequest, opts ...grpc.CallOption) (*EstimateResponse, error)
+}
+
+type pricingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPricingServiceClient(cc grpc.ClientConnInterface) PricingServiceClient {
+	return &pricingServiceClient{cc}
+}
+
+func (c *pricingServiceClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (PricingService_ListInstancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PricingService_ServiceDesc.Streams[0], PricingService_ListInstances_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pricingServiceListInstancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PricingService_ListInstancesClient interface {
+	Recv() (*ListInstancesResponse, error)
+	grpc.ClientStream
+}
+
+type pricingServiceListInstancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *pricingServiceListInstancesClient) Recv() (*ListInstancesResponse, error) {
+	m := new(ListInstancesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pricingServiceClient) GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*GetInstanceResponse, error) {
+	out := new(GetInstanceResponse)
+	err := c.cc.Invoke(ctx, PricingService_GetInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricingServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (PricingService_ListRegionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PricingService_ServiceDesc.Streams[1], PricingService_ListRegions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pricingServiceListRegionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PricingService_ListRegionsClient interface {
+	Recv() (*ListRegionsResponse, error)
+	grpc.ClientStream
+}
+
+type pricingServiceListRegionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pricingServiceListRegionsClient) Recv() (*ListRegionsResponse, error) {
+	m := new(ListRegionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pricingServiceClient) CompareInstances(ctx context.Context, in *CompareInstancesRequest, opts ...grpc.CallOption) (*CompareInstancesResponse, error) {
+	out := new(CompareInstancesResponse)
+	err := c.cc.Invoke(ctx, PricingService_CompareInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricingServiceClient) Estimate(ctx context.Context, in *EstimateRequest, opts ...grpc.CallOption) (*EstimateResponse, error) {
+	out := new(EstimateResponse)
+	err := c.cc.Invoke(ctx, PricingService_Estimate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PricingServiceServer is the server API for PricingService service.
+// All implementations must embed UnimplementedPricingServiceServer
+// for forward compatibility
+type PricingServiceServer interface {
+	// ListInstances streams the instances with the regions and terms matching the request.
+	// The count of the matched instances before pagination is sent in the x-total-count header.
+	ListInstances(*ListInstancesRequest, PricingService_ListInstancesServer) error
+	// GetInstance returns the instance with all its regions and terms.
+	GetInstance(context.Context, *GetInstanceRequest) (*GetInstanceResponse, error)
+	// ListRegions streams the regions of the providers in the catalog, ordered by the provider and the code.
+	ListRegions(*ListRegionsRequest, PricingService_ListRegionsServer) error
+	// CompareInstances compares the specs and the cheapest on-demand prices of the instances side by side.
+	CompareInstances(context.Context, *CompareInstancesRequest) (*CompareInstancesResponse, error)
+	// Estimate estimates the cost of the terms of an instance in a region over the horizon.
+	Estimate(context.Context, *EstimateRequest) (*EstimateResponse, error)
+	mustEmbedUnimplementedPricingServiceServer()
+}
+
+// UnimplementedPricingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPricingServiceServer struct {
+}
+
+func (UnimplementedPricingServiceServer) ListInstances(*ListInstancesRequest, PricingService_ListInstancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedPricingServiceServer) GetInstance(context.Context, *GetInstanceRequest) (*GetInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstance not implemented")
+}
+func (UnimplementedPricingServiceServer) ListRegions(*ListRegionsRequest, PricingService_ListRegionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
+func (UnimplementedPricingServiceServer) CompareInstances(context.Context, *CompareInstancesRequest) (*CompareInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareInstances not implemented")
+}
+func (UnimplementedPricingServiceServer) Estimate(context.Context, *EstimateRequest) (*EstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Estimate not implemented")
+}
+func (UnimplementedPricingServiceServer) mustEmbedUnimplementedPricingServiceServer() {}
+
+// UnsafePricingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PricingServiceServer will
+// result in compilation errors.
+type UnsafePricingServiceServer interface {
+	mustEmbedUnimplementedPricingServiceServer()
+}
+
+func RegisterPricingServiceServer(s grpc.ServiceRegistrar, srv PricingServiceServer) {
+	s.RegisterService(&PricingService_ServiceDesc, srv)
+}
+
+func _PricingService_ListInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PricingServiceServer).ListInstances(m, &pricingServiceListInstancesServer{stream})
+}
+
+type PricingService_ListInstancesServer interface {
+	Send(*ListInstancesResponse) error
+	grpc.ServerStream
+}
+
+type pricingServiceListInstancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *pricingServiceListInstancesServer) Send(m *ListInstancesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PricingService_GetInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).GetInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_GetInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).GetInstance(ctx, req.(*GetInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PricingService_ListRegions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRegionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PricingServiceServer).ListRegions(m, &pricingServiceListRegionsServer{stream})
+}
+
+type PricingService_ListRegionsServer interface {
+	Send(*ListRegionsResponse) error
+	grpc.ServerStream
+}
+
+type pricingServiceListRegionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pricingServiceListRegionsServer) Send(m *ListRegionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PricingService_CompareInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).CompareInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_CompareInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).CompareInstances(ctx, req.(*CompareInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PricingService_Estimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).Estimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_Estimate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).Estimate(ctx, req.(*EstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PricingService_ServiceDesc is the grpc.ServiceDesc for PricingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PricingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dbcost.v1.PricingService",
+	HandlerType: (*PricingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInstance",
+			Handler:    _PricingService_GetInstance_Handler,
+		},
+		{
+			MethodName: "CompareInstances",
+			Handler:    _PricingService_CompareInstances_Handler,
+		},
+		{
+			MethodName: "Estimate",
+			Handler:    _PricingService_Estimate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListInstances",
+			Handler:       _PricingService_ListInstances_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRegions",
+			Handler:       _PricingService_ListRegions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dbcost/v1/pricing.proto",
+}
@@ -0,0 +1,274 @@
+package region
+
+const (
+	providerAWS = "AWS"
+	providerGCP = "GCP"
+)
+
+// catalog is the canonical region list.
+// If a provider launches a new region, we need to update the list manually.
+var catalog = []*Region{
+	// North America
+	{
+		Slug: "us-east-n-virginia", Name: "US East (N. Virginia)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 38.13, Longitude: -78.45,
+		ProviderCode: map[string]string{providerAWS: "us-east-1", providerGCP: "us-east4"},
+	},
+	{
+		Slug: "us-east-ohio", Name: "US East (Ohio)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 40.42, Longitude: -82.91,
+		ProviderCode: map[string]string{providerAWS: "us-east-2"},
+	},
+	{
+		Slug: "us-east-south-carolina", Name: "US East (South Carolina)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 33.20, Longitude: -80.01,
+		ProviderCode: map[string]string{providerGCP: "us-east1"},
+	},
+	{
+		Slug: "north-america-columbus", Name: "North America (Columbus)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 39.96, Longitude: -83.00,
+		ProviderCode: map[string]string{providerGCP: "us-east5"},
+	},
+	{
+		Slug: "us-east-alabama", Name: "US East (Alabama)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 33.52, Longitude: -86.80,
+		ProviderCode: map[string]string{providerGCP: "us-east7"},
+	},
+	{
+		Slug: "us-central-iowa", Name: "US Central (Iowa)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 41.26, Longitude: -95.86,
+		ProviderCode: map[string]string{providerGCP: "us-central1"},
+	},
+	{
+		Slug: "us-central-oklahoma", Name: "US Central (Oklahoma)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 36.24, Longitude: -95.33,
+		ProviderCode: map[string]string{providerGCP: "us-central2"},
+	},
+	{
+		Slug: "us-south-dallas", Name: "US South (Dallas)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 32.78, Longitude: -96.80,
+		ProviderCode: map[string]string{providerGCP: "us-south1"},
+	},
+	{
+		Slug: "us-west-n-california", Name: "US West (N. California)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 37.35, Longitude: -121.96,
+		ProviderCode: map[string]string{providerAWS: "us-west-1"},
+	},
+	{
+		Slug: "us-west-oregon", Name: "US West (Oregon)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 45.59, Longitude: -121.18,
+		ProviderCode: map[string]string{providerAWS: "us-west-2", providerGCP: "us-west1"},
+	},
+	{
+		Slug: "us-west-los-angeles", Name: "US West (Los Angeles)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 34.05, Longitude: -118.24,
+		ProviderCode: map[string]string{providerAWS: "us-west-2-lax-1", providerGCP: "us-west2"},
+	},
+	{
+		Slug: "us-west-salt-lake-city", Name: "US West (Salt Lake City)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 40.76, Longitude: -111.89,
+		ProviderCode: map[string]string{providerGCP: "us-west3"},
+	},
+	{
+		Slug: "us-west-las-vegas", Name: "US West (Las Vegas)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 36.17, Longitude: -115.14,
+		ProviderCode: map[string]string{providerGCP: "us-west4"},
+	},
+	{
+		Slug: "aws-govcloud-us-east", Name: "AWS GovCloud (US-East)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 40.42, Longitude: -82.91,
+		ProviderCode: map[string]string{providerAWS: "us-gov-east-1"},
+	},
+	{
+		Slug: "aws-govcloud-us-west", Name: "AWS GovCloud (US-West)", Geography: "United States", Continent: ContinentNorthAmerica,
+		Latitude: 45.59, Longitude: -121.18,
+		ProviderCode: map[string]string{providerAWS: "us-gov-west-1"},
+	},
+	{
+		Slug: "canada-central", Name: "Canada (Central)", Geography: "Canada", Continent: ContinentNorthAmerica,
+		Latitude: 45.50, Longitude: -73.57,
+		ProviderCode: map[string]string{providerAWS: "ca-central-1"},
+	},
+	{
+		Slug: "canada-montreal", Name: "Canada (Montréal)", Geography: "Canada", Continent: ContinentNorthAmerica,
+		Latitude: 45.50, Longitude: -73.57,
+		ProviderCode: map[string]string{providerGCP: "northamerica-northeast1"},
+	},
+	{
+		Slug: "canada-toronto", Name: "Canada (Toronto)", Geography: "Canada", Continent: ContinentNorthAmerica,
+		Latitude: 43.65, Longitude: -79.38,
+		ProviderCode: map[string]string{providerGCP: "northamerica-northeast2"},
+	},
+
+	// South America
+	{
+		Slug: "south-america-sao-paulo", Name: "South America (Sao Paulo)", Geography: "Brazil", Continent: ContinentSouthAmerica,
+		Latitude: -23.55, Longitude: -46.63,
+		ProviderCode: map[string]string{providerAWS: "sa-east-1"},
+	},
+	{
+		Slug: "south-america-osasco", Name: "South America (Osasco)", Geography: "Brazil", Continent: ContinentSouthAmerica,
+		Latitude: -23.53, Longitude: -46.79,
+		ProviderCode: map[string]string{providerGCP: "southamerica-east1"},
+	},
+	{
+		Slug: "south-america-santiago", Name: "South America (Santiago)", Geography: "Chile", Continent: ContinentSouthAmerica,
+		Latitude: -33.45, Longitude: -70.67,
+		ProviderCode: map[string]string{providerGCP: "southamerica-west1"},
+	},
+
+	// Europe
+	{
+		Slug: "europe-frankfurt", Name: "Europe (Frankfurt)", Geography: "Germany", Continent: ContinentEurope,
+		Latitude: 50.11, Longitude: 8.68,
+		ProviderCode: map[string]string{providerAWS: "eu-central-1", providerGCP: "europe-west3"},
+	},
+	{
+		Slug: "europe-zurich", Name: "Europe (Zurich)", Geography: "Switzerland", Continent: ContinentEurope,
+		Latitude: 47.38, Longitude: 8.54,
+		ProviderCode: map[string]string{providerAWS: "eu-central-2", providerGCP: "europe-west6"},
+	},
+	{
+		Slug: "europe-stockholm", Name: "Europe (Stockholm)", Geography: "Sweden", Continent: ContinentEurope,
+		Latitude: 59.33, Longitude: 18.07,
+		ProviderCode: map[string]string{providerAWS: "eu-north-1"},
+	},
+	{
+		Slug: "europe-finland", Name: "Europe (Finland)", Geography: "Finland", Continent: ContinentEurope,
+		Latitude: 60.57, Longitude: 27.19,
+		ProviderCode: map[string]string{providerGCP: "europe-north1"},
+	},
+	{
+		Slug: "europe-milan", Name: "Europe (Milan)", Geography: "Italy", Continent: ContinentEurope,
+		Latitude: 45.46, Longitude: 9.19,
+		ProviderCode: map[string]string{providerAWS: "eu-south-1", providerGCP: "europe-west8"},
+	},
+	{
+		Slug: "europe-spain", Name: "Europe (Spain)", Geography: "Spain", Continent: ContinentEurope,
+		Latitude: 41.60, Longitude: -0.90,
+		ProviderCode: map[string]string{providerAWS: "eu-south-2"},
+	},
+	{
+		Slug: "europe-madrid", Name: "Europe (Madrid)", Geography: "Spain", Continent: ContinentEurope,
+		Latitude: 40.42, Longitude: -3.70,
+		ProviderCode: map[string]string{providerGCP: "europe-southwest1"},
+	},
+	{
+		Slug: "europe-ireland", Name: "Europe (Ireland)", Geography: "Ireland", Continent: ContinentEurope,
+		Latitude: 53.35, Longitude: -6.26,
+		ProviderCode: map[string]string{providerAWS: "eu-west-1"},
+	},
+	{
+		Slug: "europe-london", Name: "Europe (London)", Geography: "United Kingdom", Continent: ContinentEurope,
+		Latitude: 51.51, Longitude: -0.13,
+		ProviderCode: map[string]string{providerAWS: "eu-west-2", providerGCP: "europe-west2"},
+	},
+	{
+		Slug: "europe-paris", Name: "Europe (Paris)", Geography: "France", Continent: ContinentEurope,
+		Latitude: 48.86, Longitude: 2.35,
+		ProviderCode: map[string]string{providerAWS: "eu-west-3", providerGCP: "europe-west9"},
+	},
+	{
+		Slug: "europe-belgium", Name: "Europe (Belgium)", Geography: "Belgium", Continent: ContinentEurope,
+		Latitude: 50.45, Longitude: 3.82,
+		ProviderCode: map[string]string{providerGCP: "europe-west1"},
+	},
+	{
+		Slug: "europe-netherlands", Name: "Europe (Netherlands)", Geography: "Netherlands", Continent: ContinentEurope,
+		Latitude: 53.44, Longitude: 6.84,
+		ProviderCode: map[string]string{providerGCP: "europe-west4"},
+	},
+	{
+		Slug: "europe-warsaw", Name: "Europe (Warsaw)", Geography: "Poland", Continent: ContinentEurope,
+		Latitude: 52.23, Longitude: 21.01,
+		ProviderCode: map[string]string{providerGCP: "europe-central2"},
+	},
+
+	// Middle East
+	{
+		Slug: "middle-east-bahrain", Name: "Middle East (Bahrain)", Geography: "Bahrain", Continent: ContinentAsia,
+		Latitude: 26.07, Longitude: 50.56,
+		ProviderCode: map[string]string{providerAWS: "me-south-1"},
+	},
+	{
+		Slug: "middle-east-uae", Name: "Middle East (UAE)", Geography: "United Arab Emirates", Continent: ContinentAsia,
+		Latitude: 24.45, Longitude: 54.38,
+		ProviderCode: map[string]string{providerAWS: "me-central-1"},
+	},
+	{
+		Slug: "middle-east-tel-aviv", Name: "Middle East (Tel Aviv)", Geography: "Israel", Continent: ContinentAsia,
+		Latitude: 32.09, Longitude: 34.78,
+		ProviderCode: map[string]string{providerGCP: "me-west1"},
+	},
+
+	// Africa
+	{
+		Slug: "africa-cape-town", Name: "Africa (Cape Town)", Geography: "South Africa", Continent: ContinentAfrica,
+		Latitude: -33.92, Longitude: 18.42,
+		ProviderCode: map[string]string{providerAWS: "af-south-1"},
+	},
+
+	// Asia Pacific
+	{
+		Slug: "asia-pacific-hong-kong", Name: "Asia Pacific (Hong Kong)", Geography: "Hong Kong", Continent: ContinentAsia,
+		Latitude: 22.32, Longitude: 114.17,
+		ProviderCode: map[string]string{providerAWS: "ap-east-1", providerGCP: "asia-east2"},
+	},
+	{
+		Slug: "asia-pacific-taiwan", Name: "Asia Pacific (Taiwan)", Geography: "Taiwan", Continent: ContinentAsia,
+		Latitude: 24.05, Longitude: 120.52,
+		ProviderCode: map[string]string{providerGCP: "asia-east1"},
+	},
+	{
+		Slug: "asia-pacific-tokyo", Name: "Asia Pacific (Tokyo)", Geography: "Japan", Continent: ContinentAsia,
+		Latitude: 35.68, Longitude: 139.69,
+		ProviderCode: map[string]string{providerAWS: "ap-northeast-1", providerGCP: "asia-northeast1"},
+	},
+	{
+		Slug: "asia-pacific-osaka", Name: "Asia Pacific (Osaka)", Geography: "Japan", Continent: ContinentAsia,
+		Latitude: 34.69, Longitude: 135.50,
+		ProviderCode: map[string]string{providerAWS: "ap-northeast-3", providerGCP: "asia-northeast2"},
+		// AWS used to provide Osaka as a local region.
+		AliasList: []string{"Asia Pacific (Osaka-Local)"},
+	},
+	{
+		Slug: "asia-pacific-seoul", Name: "Asia Pacific (Seoul)", Geography: "South Korea", Continent: ContinentAsia,
+		Latitude: 37.57, Longitude: 126.98,
+		ProviderCode: map[string]string{providerAWS: "ap-northeast-2", providerGCP: "asia-northeast3"},
+	},
+	{
+		Slug: "asia-pacific-mumbai", Name: "Asia Pacific (Mumbai)", Geography: "India", Continent: ContinentAsia,
+		Latitude: 19.08, Longitude: 72.88,
+		ProviderCode: map[string]string{providerAWS: "ap-south-1", providerGCP: "asia-south1"},
+	},
+	{
+		Slug: "asia-pacific-hyderabad", Name: "Asia Pacific (Hyderabad)", Geography: "India", Continent: ContinentAsia,
+		Latitude: 17.39, Longitude: 78.49,
+		ProviderCode: map[string]string{providerAWS: "ap-south-2"},
+	},
+	{
+		Slug: "asia-pacific-delhi", Name: "Asia Pacific (Delhi)", Geography: "India", Continent: ContinentAsia,
+		Latitude: 28.61, Longitude: 77.21,
+		ProviderCode: map[string]string{providerGCP: "asia-south2"},
+	},
+	{
+		Slug: "asia-pacific-singapore", Name: "Asia Pacific (Singapore)", Geography: "Singapore", Continent: ContinentAsia,
+		Latitude: 1.35, Longitude: 103.82,
+		ProviderCode: map[string]string{providerAWS: "ap-southeast-1", providerGCP: "asia-southeast1"},
+	},
+	{
+		Slug: "asia-pacific-jakarta", Name: "Asia Pacific (Jakarta)", Geography: "Indonesia", Continent: ContinentAsia,
+		Latitude: -6.21, Longitude: 106.85,
+		ProviderCode: map[string]string{providerAWS: "ap-southeast-3", providerGCP: "asia-southeast2"},
+	},
+	{
+		Slug: "asia-pacific-sydney", Name: "Asia Pacific (Sydney)", Geography: "Australia", Continent: ContinentOceania,
+		Latitude: -33.87, Longitude: 151.21,
+		ProviderCode: map[string]string{providerAWS: "ap-southeast-2", providerGCP: "australia-southeast1"},
+	},
+	{
+		Slug: "asia-pacific-melbourne", Name: "Asia Pacific (Melbourne)", Geography: "Australia", Continent: ContinentOceania,
+		Latitude: -37.81, Longitude: 144.96,
+		ProviderCode: map[string]string{providerAWS: "ap-southeast-4", providerGCP: "australia-southeast2"},
+	},
+}
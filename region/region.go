@@ -0,0 +1,86 @@
+// Package region owns the canonical, provider-agnostic region catalog.
+// Cloud providers code the same location differently (e.g. N. Virginia is 'us-east-1' in AWS and 'us-east4' in GCP),
+// the catalog maps each of these provider codes to a single canonical region.
+package region
+
+// Continent is the continent a region located in.
+type Continent string
+
+const (
+	// ContinentAfrica is the continent type for Africa.
+	ContinentAfrica Continent = "AFRICA"
+	// ContinentAsia is the continent type for Asia, the Middle East is included.
+	ContinentAsia Continent = "ASIA"
+	// ContinentEurope is the continent type for Europe.
+	ContinentEurope Continent = "EUROPE"
+	// ContinentNorthAmerica is the continent type for North America.
+	ContinentNorthAmerica Continent = "NORTH_AMERICA"
+	// ContinentSouthAmerica is the continent type for South America.
+	ContinentSouthAmerica Continent = "SOUTH_AMERICA"
+	// ContinentOceania is the continent type for Oceania.
+	ContinentOceania Continent = "OCEANIA"
+)
+
+// Region is a canonical region shared by all cloud providers.
+type Region struct {
+	// e.g. europe-frankfurt
+	Slug string
+	// e.g. Europe (Frankfurt)
+	Name string
+	// Geography is the country or area the region located in, e.g. Germany.
+	Geography string
+	Continent Continent
+	Latitude  float64
+	Longitude float64
+	// ProviderCode is the mapping between the provider and the region code,
+	// e.g. {AWS: eu-central-1, GCP: europe-west3}.
+	ProviderCode map[string]string
+	// AliasList is the other names a provider may use for this region, e.g. the AWS location string.
+	AliasList []string
+}
+
+var (
+	slugMap = make(map[string]*Region)
+	// codeMap is keyed by provider and then by the provider code or alias.
+	codeMap = make(map[string]map[string]*Region)
+)
+
+func init() {
+	for _, r := range catalog {
+		slugMap[r.Slug] = r
+		for provider, code := range r.ProviderCode {
+			if _, ok := codeMap[provider]; !ok {
+				codeMap[provider] = make(map[string]*Region)
+			}
+			codeMap[provider][code] = r
+			// AWS may use the location string in place of the region code.
+			codeMap[provider][r.Name] = r
+			for _, alias := range r.AliasList {
+				codeMap[provider][alias] = r
+			}
+		}
+	}
+}
+
+// List returns all the canonical regions in the catalog.
+func List() []*Region {
+	return catalog
+}
+
+// Find returns the canonical region with the given slug.
+func Find(slug string) (*Region, bool) {
+	r, ok := slugMap[slug]
+	return r, ok
+}
+
+// Lookup returns the canonical region of the code used by the given provider.
+// The code may either be the region code (e.g. eu-central-1) or the location string (e.g. Europe (Frankfurt)).
+func Lookup(provider, code string) (*Region, bool) {
+	r, ok := codeMap[provider][code]
+	return r, ok
+}
+
+// Code returns the region code used by the given provider, empty if the provider is not available in this region.
+func (r *Region) Code(provider string) string {
+	return r.ProviderCode[provider]
+}
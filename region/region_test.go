@@ -0,0 +1,48 @@
+package region
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lookup(t *testing.T) {
+	tests := []struct {
+		provider string
+		code     string
+		slug     string
+	}{
+		{providerAWS, "eu-central-1", "europe-frankfurt"},
+		{providerGCP, "europe-west3", "europe-frankfurt"},
+		{providerAWS, "us-east-1", "us-east-n-virginia"},
+		{providerGCP, "us-east4", "us-east-n-virginia"},
+		// AWS location strings
+		{providerAWS, "Europe (Frankfurt)", "europe-frankfurt"},
+		{providerAWS, "Asia Pacific (Osaka-Local)", "asia-pacific-osaka"},
+	}
+	for _, test := range tests {
+		r, ok := Lookup(test.provider, test.code)
+		require.True(t, ok, "region %s of %s not found", test.code, test.provider)
+		require.Equal(t, test.slug, r.Slug)
+	}
+
+	_, ok := Lookup(providerGCP, "eu-central-1")
+	require.False(t, ok)
+	_, ok = Lookup(providerAWS, "mars-north-1")
+	require.False(t, ok)
+}
+
+func Test_Catalog(t *testing.T) {
+	codeSet := make(map[string]bool)
+	for _, r := range List() {
+		found, ok := Find(r.Slug)
+		require.True(t, ok)
+		require.Equal(t, r, found, "duplicated slug %s", r.Slug)
+		require.NotEmpty(t, r.ProviderCode, "region %s has no provider code", r.Slug)
+		for provider, code := range r.ProviderCode {
+			key := provider + "/" + code
+			require.False(t, codeSet[key], "duplicated code %s", key)
+			codeSet[key] = true
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runBreakEven analyzes when the reserved terms of an instance beat on-demand and which term is the cheapest.
+// e.g. go run ./seed breakeven -instance AWS:db.r6g.4xlarge -region us-east-1 -engine POSTGRES -months 36
+func runBreakEven(args []string) {
+	fs := flag.NewFlagSet("breakeven", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	externalID := fs.String("instance", "", "the external ID of the instance, e.g. AWS:db.r6g.4xlarge")
+	regionCode := fs.String("region", "", "the region code of the provider, e.g. us-east-1")
+	engine := fs.String("engine", client.EngineTypeMySQL, "the database engine, MYSQL or POSTGRES")
+	months := fs.Float64("months", 36, "the months of the horizon")
+	utilization := fs.Float64("utilization", 1, "the ratio of the horizon the instance is running, from 0 to 1")
+	format := fs.String("format", "text", "the output format, text or json")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	if *externalID == "" || *regionCode == "" {
+		log.Fatalf("Both -instance and -region are required.\n")
+	}
+
+	catalog, err := store.LoadCatalog(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	dbInstance, ok := catalog.GetDBInstance(*externalID)
+	if !ok {
+		log.Fatalf("Instance %s not found.\n", *externalID)
+	}
+	var region *store.Region
+	for _, r := range dbInstance.RegionList {
+		if r.Code == *regionCode {
+			region = r
+		}
+	}
+	if region == nil {
+		log.Fatalf("Instance %s is not provided in %s.\n", *externalID, *regionCode)
+	}
+
+	analysis, err := cost.Analyze(region, client.EngineType(*engine), cost.Months(*months), *utilization)
+	if err != nil {
+		log.Fatalf("Fail to analyze, err: %s.\n", err)
+	}
+	switch *format {
+	case "text":
+		printAnalysis(analysis)
+	case "json":
+		dataByted, err := json.MarshalIndent(analysis, "", "  ")
+		if err != nil {
+			log.Fatalf("Fail to marshal the analysis, err: %s.\n", err)
+		}
+		fmt.Println(string(dataByted))
+	default:
+		log.Fatalf("Unknown format %q, allowed formats are text and json.\n", *format)
+	}
+}
+
+func printAnalysis(analysis *cost.Analysis) {
+	fmt.Printf("%s over %v months at %v%% utilization, the cheapest term is %s (%s).\n\n",
+		analysis.DatabaseEngine, analysis.Horizon.Months(), analysis.Utilization*100,
+		analysis.Cheapest.Term.Code, analysis.Cheapest.Term.GetName())
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TERM\tOPTION\tUPFRONT\tTOTAL\tMONTHLY\tSAVING\tBREAK-EVEN")
+	for _, termAnalysis := range analysis.TermAnalysisList {
+		breakEven := "never"
+		if termAnalysis.BreakEvenMonth != nil {
+			breakEven = fmt.Sprintf("%.1f months", *termAnalysis.BreakEvenMonth)
+		}
+		estimate := termAnalysis.Estimate
+		fmt.Fprintf(w, "%s\t%s\t$%.2f\t$%.2f\t$%.2f\t%+.2f%%\t%s\n",
+			termAnalysis.Term.Code, termAnalysis.Term.GetName(),
+			estimate.UpfrontUSD, estimate.TotalUSD, estimate.MonthlyUSD, termAnalysis.SavingPercent, breakEven)
+	}
+	w.Flush()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+// runCheck checks the data quality of the new dbInstance file against the old one, and exits with 1 if any issue is found.
+// e.g. go run ./seed check -old old.json -new data/dbInstance.json
+func runCheck(args []string) {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	oldPath := fs.String("old", "", "the path of the old dbInstance file, the rules comparing snapshots are skipped if empty")
+	newPath := fs.String("new", "", "the path of the new dbInstance file")
+	format := fs.String("format", "markdown", "the output format, markdown or json")
+	maxPriceChange := fs.Float64("max-price-change", store.DefaultQualityConfig.MaxPriceChangePercent, "the max absolute change percent of a price")
+	maxInstanceDrop := fs.Float64("max-instance-drop", store.DefaultQualityConfig.MaxInstanceDropPercent, "the max percent of the instances of a provider dropped")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	if *newPath == "" {
+		log.Fatalf("-new is required.\n")
+	}
+
+	input := &store.QualityInput{}
+	var err error
+	if *oldPath != "" {
+		if input.PreviousList, err = store.Load(*oldPath); err != nil {
+			log.Fatalf("Fail to load the old file, err: %s.\n", err)
+		}
+	}
+	if input.CurrentList, err = store.Load(*newPath); err != nil {
+		log.Fatalf("Fail to load the new file, err: %s.\n", err)
+	}
+
+	config := &store.QualityConfig{MaxPriceChangePercent: *maxPriceChange, MaxInstanceDropPercent: *maxInstanceDrop}
+	report := store.CheckQuality(input, store.GetQualityRuleList(config))
+	switch *format {
+	case "markdown":
+		fmt.Print(report.Markdown())
+	case "json":
+		dataByted, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Fail to marshal the report, err: %s.\n", err)
+		}
+		fmt.Println(string(dataByted))
+	default:
+		log.Fatalf("Unknown format %q, allowed formats are markdown and json.\n", *format)
+	}
+	if !report.IsEmpty() {
+		os.Exit(1)
+	}
+}
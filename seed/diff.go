@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+// runDiff compares two snapshots of the dbInstance file and prints the difference.
+// e.g. go run ./seed diff -old old.json -new data/dbInstance.json -format markdown
+func runDiff(args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	oldPath := fs.String("old", "", "the path of the old dbInstance file")
+	newPath := fs.String("new", "", "the path of the new dbInstance file")
+	format := fs.String("format", "markdown", "the output format, markdown or json")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	if *oldPath == "" || *newPath == "" {
+		log.Fatalf("Both -old and -new are required.\n")
+	}
+
+	oldList, err := store.Load(*oldPath)
+	if err != nil {
+		log.Fatalf("Fail to load the old file, err: %s.\n", err)
+	}
+	newList, err := store.Load(*newPath)
+	if err != nil {
+		log.Fatalf("Fail to load the new file, err: %s.\n", err)
+	}
+
+	diff := store.Compare(oldList, newList)
+	switch *format {
+	case "markdown":
+		fmt.Print(diff.Markdown())
+	case "json":
+		dataByted, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			log.Fatalf("Fail to marshal the diff, err: %s.\n", err)
+		}
+		fmt.Println(string(dataByted))
+	default:
+		log.Fatalf("Unknown format %q, allowed formats are markdown and json.\n", *format)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/bytebase/dbcost/iac"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runEstimate estimates the monthly cost of the database instances declared in the IaC inputs,
+// i.e. Terraform plans, CloudFormation templates and Kubernetes manifests.
+// e.g. terraform show -json plan.out > plan.json && go run ./seed estimate plan.json
+func runEstimate(args []string) {
+	fs := flag.NewFlagSet("estimate", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	defaultRegion := fs.String("region", "", "the region of the resources whose region is not known from the input, e.g. us-east-1")
+	input := fs.String("input", "auto", "the format of the inputs, auto, terraform, cloudformation or kubernetes")
+	format := fs.String("format", "markdown", "the output format, markdown or json")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	if fs.NArg() == 0 {
+		log.Fatalf("At least one input file is required.\n")
+	}
+
+	catalog, err := store.LoadCatalog(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	var changeList []*iac.Change
+	for _, inputPath := range fs.Args() {
+		dataByted, err := os.ReadFile(inputPath)
+		if err != nil {
+			log.Fatalf("Fail to read the file %s, err: %s.\n", inputPath, err)
+		}
+		inputFormat := iac.Format(*input)
+		if *input == "auto" {
+			if inputFormat, err = iac.DetectFormat(dataByted); err != nil {
+				log.Fatalf("Fail to detect the format of the file %s, err: %s.\n", inputPath, err)
+			}
+		}
+		fileChangeList, err := iac.Parse(inputFormat, dataByted, *defaultRegion)
+		if err != nil {
+			log.Fatalf("Fail to parse the file %s, err: %s.\n", inputPath, err)
+		}
+		changeList = append(changeList, fileChangeList...)
+	}
+
+	report := iac.Estimate(catalog, changeList)
+	switch *format {
+	case "markdown":
+		fmt.Print(report.Markdown())
+	case "json":
+		dataByted, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Fail to marshal the report, err: %s.\n", err)
+		}
+		fmt.Println(string(dataByted))
+	default:
+		log.Fatalf("Unknown format %q, allowed formats are markdown and json.\n", *format)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/bytebase/dbcost/export"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runExport exports the dbInstance file as one row per price point.
+// e.g. go run ./seed export -file data/dbInstance.json -format csv,xlsx
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	format := fs.String("format", "csv", "the comma separated export formats, csv, parquet or xlsx")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	formatList, err := export.ParseFormatList(*format)
+	if err != nil {
+		log.Fatalf("Fail to parse -format, err: %s.\n", err)
+	}
+
+	dbInstanceList, err := store.Load(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	saveExport(dbInstanceList, *filePath, formatList)
+}
+
+// saveExport saves the exports next to the dbInstance file, e.g. data/dbInstance.csv.
+func saveExport(dbInstanceList []*store.DBInstance, filePath string, formatList []export.Format) {
+	if len(formatList) == 0 {
+		return
+	}
+	rowList := export.Flatten(dbInstanceList)
+	// the compression extension is trimmed as well, e.g. data/dbInstance.json.gz is exported to data/dbInstance.csv.
+	basePath := filePath
+	for _, compression := range []store.Compression{store.CompressionGzip, store.CompressionBrotli} {
+		basePath = strings.TrimSuffix(basePath, compression.Extension())
+	}
+	basePath = strings.TrimSuffix(basePath, ".json")
+	for _, format := range formatList {
+		exportPath := basePath + "." + string(format)
+		if err := export.Save(rowList, exportPath, format); err != nil {
+			log.Fatalf("Fail to export %s, err: %s.\n", format, err)
+		}
+		log.Printf("Exported %d row to: %s.\n", len(rowList), exportPath)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/bytebase/dbcost/store/sqlite"
+)
+
+// runImport imports the dbInstance file into the SQLite database as a new snapshot.
+// e.g. go run ./seed import -db data/dbcost.db -file data/dbInstance.json
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dbPath := fs.String("db", "data/dbcost.db", "the path of the SQLite database, created if not exist")
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+
+	ctx := context.Background()
+	s, err := sqlite.Open(ctx, *dbPath)
+	if err != nil {
+		log.Fatalf("Fail to open the database, err: %s.\n", err)
+	}
+	defer s.Close()
+
+	snapshotID, err := s.ImportFile(ctx, *filePath, time.Now().Unix())
+	if err != nil {
+		log.Fatalf("Fail to import the file, err: %s.\n", err)
+	}
+	log.Printf("Imported %s to %s as snapshot %d.\n", *filePath, *dbPath, snapshotID)
+}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
 	"path"
+	"runtime/debug"
 	"sort"
+	"time"
 
 	"github.com/bytebase/dbcost/client"
 	"github.com/bytebase/dbcost/client/aws"
 	"github.com/bytebase/dbcost/client/gcp"
+	"github.com/bytebase/dbcost/export"
 	"github.com/bytebase/dbcost/store"
 )
 
@@ -17,6 +21,12 @@ const (
 	renderEnvKey = "API_KEY_GCP"
 	dirPath      = "data"
 	fileName     = "dbInstance.json"
+	// historyFileName is the append-only change log of the prices.
+	historyFileName = "priceHistory.jsonl"
+	// overrideFileName is the manual corrections to the upstream data, JSON is also accepted with the .json extension.
+	overrideFileName = "override.yaml"
+	// shardDirName is the directory of the sharded data, with the manifest index.json in it.
+	shardDirName = "shard"
 )
 
 type ProviderPair struct {
@@ -25,6 +35,71 @@ type ProviderPair struct {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			runDiff(os.Args[2:])
+			return
+		case "import":
+			runImport(os.Args[2:])
+			return
+		case "schema":
+			runSchema(os.Args[2:])
+			return
+		case "validate":
+			runValidate(os.Args[2:])
+			return
+		case "check":
+			runCheck(os.Args[2:])
+			return
+		case "export":
+			runExport(os.Args[2:])
+			return
+		case "breakeven":
+			runBreakEven(os.Args[2:])
+			return
+		case "recommend":
+			runRecommend(os.Args[2:])
+			return
+		case "estimate":
+			runEstimate(os.Args[2:])
+			return
+		case "pricing-api":
+			runPricingAPI(os.Args[2:])
+			return
+		case "serve":
+			runServe(os.Args[2:])
+			return
+		}
+	}
+	runSeed(os.Args[1:])
+}
+
+// runSeed fetches the latest pricing data from all the providers and saves it to local.
+// The data is not saved if any data quality issue is found.
+func runSeed(args []string) {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	maxPriceChange := fs.Float64("max-price-change", store.DefaultQualityConfig.MaxPriceChangePercent, "the max absolute change percent of a price compared to the previous data")
+	maxInstanceDrop := fs.Float64("max-instance-drop", store.DefaultQualityConfig.MaxInstanceDropPercent, "the max percent of the instances of a provider dropped compared to the previous data")
+	compress := fs.String("compress", "", "the comma separated compressed variants to save besides the JSON, gzip or br")
+	shard := fs.String("shard", "", "the comma separated dimensions to shard the data by, provider, region or instance")
+	exportFormat := fs.String("export", "", "the comma separated formats to export the data as besides the JSON, csv, parquet or xlsx")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	compressionList, err := store.ParseCompressionList(*compress)
+	if err != nil {
+		log.Fatalf("Fail to parse -compress, err: %s.\n", err)
+	}
+	shardByList, err := store.ParseShardByList(*shard)
+	if err != nil {
+		log.Fatalf("Fail to parse -shard, err: %s.\n", err)
+	}
+	exportFormatList, err := export.ParseFormatList(*exportFormat)
+	if err != nil {
+		log.Fatalf("Fail to parse -export, err: %s.\n", err)
+	}
+
 	apiKeyGCP := os.Getenv(renderEnvKey)
 	if apiKeyGCP == "" {
 		log.Fatalf("Env variable API_KEY_GCP not found, please set your API key in your environment first.\n")
@@ -35,17 +110,43 @@ func main() {
 		{store.CloudProviderAWS, aws.NewClient()},
 	}
 
-	incrID := 0
+	ts := time.Now().Unix()
+	targetFilePath := path.Join(dirPath, fileName)
+	previous := &store.Dataset{Metadata: &store.Metadata{}}
+	if _, err := os.Stat(targetFilePath); err == nil {
+		if previous, err = store.LoadDataset(targetFilePath); err != nil {
+			log.Fatalf("Fail to load the previous data, err: %s.\n", err)
+		}
+		if previous.Metadata == nil {
+			previous.Metadata = &store.Metadata{}
+		}
+	}
+
+	overrideFilePath := path.Join(dirPath, overrideFileName)
+	overrideList, err := store.LoadOverride(overrideFilePath)
+	if err != nil {
+		log.Fatalf("Fail to load the overrides, err: %s.\n", err)
+	}
+
+	metadata := &store.Metadata{
+		GeneratedTs: ts,
+		Version:     getVersion(),
+	}
 	var dbInstanceList []*store.DBInstance
+	fetchedProviderMap := make(map[string]bool)
 	for _, pair := range cloudProviderList {
 		log.Printf("--------Fetching %s--------\n", pair.Provider)
 		offerList, err := pair.Client.GetOffer()
-		// sort offerList to generate a stable output
-		sort.SliceStable(offerList, func(i, j int) bool { return offerList[i].TermCode < offerList[j].TermCode })
 		if err != nil {
 			log.Printf("Error occurred when fetching %s's entry.\n", pair.Provider)
+			// keep the provenance of the previous data, as it is kept as is.
+			if source := previous.Metadata.GetSource(pair.Provider); source != nil {
+				metadata.SourceList = append(metadata.SourceList, source)
+			}
 			continue
 		}
+		// sort offerList to generate a stable output
+		sort.SliceStable(offerList, func(i, j int) bool { return offerList[i].TermCode < offerList[j].TermCode })
 		log.Printf("Fetched %d offer entry.\n", len(offerList))
 
 		providerDBInstanceList, err := store.Convert(offerList, pair.Provider)
@@ -54,11 +155,71 @@ func main() {
 		}
 		log.Printf("Converted to %d dbInstance entry.\n", len(providerDBInstanceList))
 
-		for _, instance := range providerDBInstanceList {
-			instance.ID = incrID
-			dbInstanceList = append(dbInstanceList, instance)
-			incrID++
+		dbInstanceList = append(dbInstanceList, providerDBInstanceList...)
+		fetchedProviderMap[pair.Provider.String()] = true
+
+		source := &store.Source{
+			CloudProvider: pair.Provider.String(),
+			FetchedTs:     ts,
+			OfferCount:    len(offerList),
+			InstanceCount: len(providerDBInstanceList),
+		}
+		if sourceClient, ok := pair.Client.(client.SourceClient); ok && sourceClient.GetSource() != nil {
+			clientSource := sourceClient.GetSource()
+			source.URL = clientSource.URL
+			source.Version = clientSource.Version
+			source.PublicationDate = clientSource.PublicationDate
+			source.PageCount = clientSource.PageCount
 		}
+		metadata.SourceList = append(metadata.SourceList, source)
+	}
+
+	// Overrides are applied before merging, so that the overridden rows are not considered as updated on each run.
+	// Overrides of the providers failed to fetch are skipped, as the previous data of them is kept as is.
+	var fetchedOverrideList []*store.Override
+	var overrideIndexList []int
+	for i, override := range overrideList {
+		if fetchedProviderMap[override.GetCloudProvider()] {
+			fetchedOverrideList = append(fetchedOverrideList, override)
+			overrideIndexList = append(overrideIndexList, i)
+		}
+	}
+	dbInstanceList, staleOverrideList, err := store.ApplyOverride(dbInstanceList, fetchedOverrideList)
+	if err != nil {
+		log.Fatalf("Fail to apply the overrides, err: %s.\n", err)
+	}
+	for _, stale := range staleOverrideList {
+		log.Printf("WARNING: override #%d (%s) is stale and skipped: %s.\n", overrideIndexList[stale.Index], stale.Override.Action, stale.Reason)
+	}
+	metadata.OverrideCount = len(fetchedOverrideList) - len(staleOverrideList)
+	log.Printf("Applied %d override, %d stale.\n", metadata.OverrideCount, len(staleOverrideList))
+
+	// Merge against the previous data so that instances and terms missing upstream are archived.
+	// The previous data of the providers failed to fetch is kept as is, rather than being archived.
+	// The hidden instances are excluded from the previous data, so that they are removed rather than archived.
+	var previousFetchedList, unfetchedList []*store.DBInstance
+	for _, instance := range store.ExcludeHidden(previous.DBInstanceList, fetchedOverrideList) {
+		if fetchedProviderMap[instance.CloudProvider] {
+			previousFetchedList = append(previousFetchedList, instance)
+		} else {
+			unfetchedList = append(unfetchedList, instance)
+		}
+	}
+	dbInstanceList = append(store.Merge(previousFetchedList, dbInstanceList, ts), unfetchedList...)
+	// the ID of each instance is derived from its content, we only need to keep the output order stable.
+	store.Sort(dbInstanceList)
+	// the equivalents are matched over all the providers, including the unfetched ones.
+	store.MatchEquivalent(dbInstanceList)
+	metadata.InstanceCount = len(dbInstanceList)
+
+	qualityConfig := &store.QualityConfig{MaxPriceChangePercent: *maxPriceChange, MaxInstanceDropPercent: *maxInstanceDrop}
+	report := store.CheckQuality(&store.QualityInput{
+		PreviousList: previous.DBInstanceList,
+		CurrentList:  dbInstanceList,
+	}, store.GetQualityRuleList(qualityConfig))
+	if !report.IsEmpty() {
+		log.Print(report.Markdown())
+		log.Fatalf("Found %d data quality issue, the data is not saved.\n", len(report.IssueList))
 	}
 
 	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
@@ -67,14 +228,48 @@ func main() {
 
 	log.Printf("Saving data, total entry: %d.\n", len(dbInstanceList))
 
-	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
-		log.Fatalf("Fail to make dir, err: err.\n")
+	dataset := &store.Dataset{
+		Metadata:       metadata,
+		DBInstanceList: dbInstanceList,
 	}
-
-	targetFilePath := path.Join(dirPath, fileName)
-	if err := store.Save(dbInstanceList, targetFilePath); err != nil {
+	if err := store.Save(dataset, targetFilePath, compressionList...); err != nil {
 		log.Fatalf("Fail to save data, err: %s.\n", err)
 	}
 	log.Printf("File saved to: %s.\n", targetFilePath)
 
+	if len(shardByList) > 0 {
+		shardDirPath := path.Join(dirPath, shardDirName)
+		manifest, err := store.SaveShard(dataset, shardDirPath, shardByList, compressionList...)
+		if err != nil {
+			log.Fatalf("Fail to save the sharded data, err: %s.\n", err)
+		}
+		log.Printf("Saved %d shard to: %s.\n", len(manifest.ShardList), shardDirPath)
+	}
+	saveExport(dbInstanceList, targetFilePath, exportFormatList)
+
+	historyFilePath := path.Join(dirPath, historyFileName)
+	recordCount, err := store.AppendHistory(historyFilePath, dbInstanceList, ts)
+	if err != nil {
+		log.Fatalf("Fail to append price history, err: %s.\n", err)
+	}
+	log.Printf("Appended %d price record to: %s.\n", recordCount, historyFilePath)
+}
+
+// version is the version of dbcost, which can be set on build by
+// go build -ldflags "-X main.version=${VERSION}".
+var version = ""
+
+// getVersion returns the version of dbcost, the VCS revision is used if the version is not set on build.
+func getVersion() string {
+	if version != "" {
+		return version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" {
+				return setting.Value
+			}
+		}
+	}
+	return "development"
 }
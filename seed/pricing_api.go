@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/bytebase/dbcost/infracost"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runPricingAPI serves the Infracost compatible pricing GraphQL API backed by the dataset.
+// e.g. go run ./seed pricing-api -addr :4000
+func runPricingAPI(args []string) {
+	fs := flag.NewFlagSet("pricing-api", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	addr := fs.String("addr", ":4000", "the address to listen on")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+
+	dbInstanceList, err := store.Load(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	handler, err := infracost.NewHandler(dbInstanceList)
+	if err != nil {
+		log.Fatalf("Fail to build the GraphQL schema, err: %s.\n", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", handler)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	log.Printf("Serving the pricing API of %d instances on %s.\n", len(dbInstanceList), *addr)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatalf("Fail to serve, err: %s.\n", err)
+	}
+}
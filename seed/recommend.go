@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runRecommend recommends the cheapest instances matching the requirement across providers.
+// e.g. go run ./seed recommend -engine POSTGRES -cpu 8 -memory 32 -continent EUROPE
+func runRecommend(args []string) {
+	fs := flag.NewFlagSet("recommend", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	engine := fs.String("engine", "", "the database engine, MYSQL or POSTGRES, empty for both")
+	minCPU := fs.Int("cpu", 0, "the min vCPU")
+	minMemory := fs.Float64("memory", 0, "the min memory in GiB")
+	providers := fs.String("provider", "", "the comma separated allowed providers, e.g. AWS,GCP")
+	regions := fs.String("region", "", "the comma separated allowed region codes or slugs, e.g. eu-central-1,europe-frankfurt")
+	continents := fs.String("continent", "", "the comma separated allowed continents, e.g. EUROPE,NORTH_AMERICA")
+	ha := fs.Bool("ha", false, "require high availability, estimated as twice the single-zone price")
+	chargeType := fs.String("charge", "", "the preferred charge type, OnDemand or Reserved, empty for both")
+	months := fs.Float64("months", 12, "the months of the horizon")
+	utilization := fs.Float64("utilization", 1, "the ratio of the horizon the instance is running, from 0 to 1")
+	limit := fs.Int("limit", 10, "the max count of the recommendations, 0 for all")
+	format := fs.String("format", "text", "the output format, text or json")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+
+	requirement := &cost.Requirement{
+		DatabaseEngine:    client.EngineType(*engine),
+		MinCPU:            *minCPU,
+		MinMemory:         *minMemory,
+		CloudProviderList: splitFlag(*providers),
+		RegionList:        splitFlag(*regions),
+		HighAvailability:  *ha,
+		ChargeType:        client.ChargeType(*chargeType),
+		Horizon:           cost.Months(*months),
+		Utilization:       *utilization,
+		Limit:             *limit,
+	}
+	for _, continent := range splitFlag(*continents) {
+		requirement.ContinentList = append(requirement.ContinentList, region.Continent(strings.ToUpper(continent)))
+	}
+
+	catalog, err := store.LoadCatalog(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	recommendationList, err := cost.Recommend(catalog, requirement)
+	if err != nil {
+		log.Fatalf("Fail to recommend, err: %s.\n", err)
+	}
+	switch *format {
+	case "text":
+		printRecommendation(recommendationList)
+	case "json":
+		dataByted, err := json.MarshalIndent(recommendationList, "", "  ")
+		if err != nil {
+			log.Fatalf("Fail to marshal the recommendations, err: %s.\n", err)
+		}
+		fmt.Println(string(dataByted))
+	default:
+		log.Fatalf("Unknown format %q, allowed formats are text and json.\n", *format)
+	}
+}
+
+func printRecommendation(recommendationList []*cost.Recommendation) {
+	if len(recommendationList) == 0 {
+		fmt.Println("No instance matches the requirement.")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RANK\tINSTANCE\tREGION\tENGINE\tTERM\tTOTAL\tMONTHLY")
+	for _, recommendation := range recommendationList {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t$%.2f\t$%.2f\n",
+			recommendation.Rank, recommendation.DBInstance.GetExternalID(), recommendation.Region.Code, recommendation.Term.DatabaseEngine,
+			recommendation.Term.GetName(), recommendation.Estimate.TotalUSD, recommendation.Estimate.MonthlyUSD)
+	}
+	w.Flush()
+
+	fmt.Println()
+	for _, recommendation := range recommendationList {
+		fmt.Printf("#%d %s in %s:\n", recommendation.Rank, recommendation.DBInstance.GetExternalID(), recommendation.Region.Code)
+		for _, reason := range recommendation.ReasonList {
+			fmt.Printf("  - %s\n", reason)
+		}
+	}
+}
+
+// splitFlag splits the comma separated flag value, the empty items are dropped.
+func splitFlag(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
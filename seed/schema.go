@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+// schemaDirPath is the directory of the published schemas, each schema version is kept in its own file.
+const schemaDirPath = "schema"
+
+// runSchema generates the JSON Schema of the dataset from the store types.
+// e.g. go run ./seed schema
+func runSchema(args []string) {
+	fs := flag.NewFlagSet("schema", flag.ExitOnError)
+	out := fs.String("out", path.Join(schemaDirPath, fmt.Sprintf("dataset.v%d.json", store.SchemaVersion)), "the path to write the schema to, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+
+	dataByted, err := store.MarshalSchema()
+	if err != nil {
+		log.Fatalf("Fail to generate the schema, err: %s.\n", err)
+	}
+	if *out == "-" {
+		fmt.Print(string(dataByted))
+		return
+	}
+	if err := os.MkdirAll(path.Dir(*out), os.ModePerm); err != nil {
+		log.Fatalf("Fail to make dir, err: %s.\n", err)
+	}
+	if err := os.WriteFile(*out, dataByted, 0644); err != nil {
+		log.Fatalf("Fail to write the schema, err: %s.\n", err)
+	}
+	log.Printf("Schema v%d saved to: %s.\n", store.SchemaVersion, *out)
+}
+
+// runValidate validates the dataset files against the schema version each file declares, and exits with 1 if any violation is found.
+// e.g. go run ./seed validate data/dbInstance.json
+func runValidate(args []string) {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+	filePathList := fs.Args()
+	if len(filePathList) == 0 {
+		filePathList = []string{path.Join(dirPath, fileName)}
+	}
+
+	violationCount := 0
+	for _, filePath := range filePathList {
+		dataByted, err := os.ReadFile(filePath)
+		if err != nil {
+			log.Fatalf("Fail to read the file %s, err: %s.\n", filePath, err)
+		}
+		errorList, schemaVersion, err := validateDataset(dataByted)
+		if err != nil {
+			log.Fatalf("Fail to validate the file %s, err: %s.\n", filePath, err)
+		}
+		for _, validationError := range errorList {
+			fmt.Printf("%s: %s\n", filePath, validationError)
+		}
+		log.Printf("Validated %s against schema v%d, %d violation found.\n", filePath, schemaVersion, len(errorList))
+		violationCount += len(errorList)
+	}
+	if violationCount > 0 {
+		os.Exit(1)
+	}
+}
+
+// validateDataset validates the dataset against the published schema of the version it declares, and returns the version validated against.
+// The data of the current version, or of a version never published, is validated against the current schema.
+func validateDataset(dataByted []byte) ([]*store.ValidationError, int, error) {
+	schemaVersion, err := store.GetSchemaVersion(dataByted)
+	if err != nil {
+		return nil, 0, err
+	}
+	if schemaVersion != store.SchemaVersion {
+		schemaByted, err := os.ReadFile(path.Join(schemaDirPath, fmt.Sprintf("dataset.v%d.json", schemaVersion)))
+		if err == nil {
+			errorList, err := store.ValidateDatasetWithSchema(dataByted, schemaByted)
+			return errorList, schemaVersion, err
+		}
+		if !os.IsNotExist(err) {
+			return nil, 0, err
+		}
+	}
+	errorList, err := store.ValidateDataset(dataByted)
+	return errorList, store.SchemaVersion, err
+}
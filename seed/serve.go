@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/bytebase/dbcost/infracost"
+	"github.com/bytebase/dbcost/server"
+	"github.com/bytebase/dbcost/store"
+)
+
+// runServe serves the REST API backed by the dataset, with the Infracost compatible pricing API mounted at /graphql,
+// and the gRPC PricingService on a separate address sharing the same catalog.
+// e.g. go run ./seed serve -addr :8080 -grpc-addr :9090
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	filePath := fs.String("file", "data/dbInstance.json", "the path of the dbInstance file")
+	addr := fs.String("addr", ":8080", "the address to listen on")
+	grpcAddr := fs.String("grpc-addr", ":9090", "the address of the gRPC server to listen on, the gRPC server is disabled if empty")
+	if err := fs.Parse(args); err != nil {
+		log.Fatalf("Fail to parse the flags, err: %s.\n", err)
+	}
+
+	dataset, err := store.LoadDataset(*filePath)
+	if err != nil {
+		log.Fatalf("Fail to load the file, err: %s.\n", err)
+	}
+	s, err := server.NewServer(dataset)
+	if err != nil {
+		log.Fatalf("Fail to create the server, err: %s.\n", err)
+	}
+	handler, err := infracost.NewHandler(dataset.DBInstanceList)
+	if err != nil {
+		log.Fatalf("Fail to build the GraphQL schema, err: %s.\n", err)
+	}
+	s.Handle("/graphql", handler)
+	s.Handle("/health", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	if *grpcAddr != "" {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			log.Fatalf("Fail to listen on %s, err: %s.\n", *grpcAddr, err)
+		}
+		grpcServer := server.NewGRPCServer(s)
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				log.Fatalf("Fail to serve gRPC, err: %s.\n", err)
+			}
+		}()
+		log.Printf("Serving gRPC on %s.\n", *grpcAddr)
+	}
+	log.Printf("Serving %d instances of dataset version %s on %s.\n", len(dataset.DBInstanceList), s.DatasetVersion(), *addr)
+	if err := http.ListenAndServe(*addr, s); err != nil {
+		log.Fatalf("Fail to serve, err: %s.\n", err)
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+)
+
+// comparePrice is the cheapest on-demand price of an instance in a region.
+type comparePrice struct {
+	Code          string  `json:"code"`
+	Slug          string  `json:"slug"`
+	Name          string  `json:"name"`
+	TermCode      string  `json:"termCode"`
+	HourlyUSD     float64 `json:"hourlyUSD"`
+	MonthlyUSD    float64 `json:"monthlyUSD"`
+	USDPerCPUHour float64 `json:"usdPerCPUHour"`
+	USDPerGiBHour float64 `json:"usdPerGiBHour"`
+}
+
+// compareItem is an instance compared, the regions are excluded from the instance and listed as the prices.
+type compareItem struct {
+	DBInstance *store.DBInstance `json:"dbInstance"`
+	PriceList  []*comparePrice   `json:"priceList"`
+	// Cheapest is the cheapest price among the regions, nil if the instance is not provided in any region matched.
+	Cheapest *comparePrice `json:"cheapest"`
+}
+
+// compareResponse is the response of GET /v1/compare.
+type compareResponse struct {
+	DatabaseEngine client.EngineType `json:"databaseEngine"`
+	ItemList       []*compareItem    `json:"itemList"`
+}
+
+// compare compares the specs and the on-demand prices of the instances side by side, the parameters are
+//   - instance, the external ID of the instance, e.g. AWS:db.r6g.xlarge, repeated for each instance.
+//     If only one instance is given, it is compared with its equivalents on the other providers.
+//   - engine, required, e.g. POSTGRES.
+//   - region, either the region code of the provider or the canonical region slug, all regions if empty.
+func (s *Server) compare(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+	response, err := s.compareDBInstance(values["instance"], client.EngineType(strings.ToUpper(values.Get("engine"))), values.Get("region"))
+	if err != nil {
+		writeError(w, getStatus(err), "%s", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// compareDBInstance compares the instances in the region of the code or the slug, all regions if empty.
+func (s *Server) compareDBInstance(externalIDList []string, engine client.EngineType, regionCode string) (*compareResponse, error) {
+	if len(externalIDList) == 0 {
+		return nil, fmt.Errorf("at least one instance is required")
+	}
+	if engine == "" {
+		return nil, fmt.Errorf("engine is required")
+	}
+
+	var dbInstanceList []*store.DBInstance
+	for _, externalID := range externalIDList {
+		dbInstance, ok := s.catalog.GetDBInstance(externalID)
+		if !ok {
+			return nil, &notFoundError{message: fmt.Sprintf("instance %s is not found", externalID)}
+		}
+		dbInstanceList = append(dbInstanceList, dbInstance)
+	}
+	if len(dbInstanceList) == 1 {
+		for _, equivalent := range dbInstanceList[0].EquivalentList {
+			if dbInstance, ok := s.catalog.GetDBInstance(equivalent.ExternalID); ok {
+				dbInstanceList = append(dbInstanceList, dbInstance)
+			}
+		}
+	}
+
+	_, isSlug := region.Find(regionCode)
+	response := &compareResponse{DatabaseEngine: engine}
+	for _, dbInstance := range dbInstanceList {
+		instanceCopy := *dbInstance
+		instanceCopy.RegionList = nil
+		item := &compareItem{DBInstance: &instanceCopy}
+		for _, instanceRegion := range dbInstance.RegionList {
+			if regionCode != "" && ((isSlug && instanceRegion.Slug != regionCode) || (!isSlug && instanceRegion.Code != regionCode)) {
+				continue
+			}
+			price := getOnDemandPrice(instanceRegion, engine)
+			if price == nil {
+				continue
+			}
+			item.PriceList = append(item.PriceList, price)
+			if item.Cheapest == nil || price.HourlyUSD < item.Cheapest.HourlyUSD {
+				item.Cheapest = price
+			}
+		}
+		response.ItemList = append(response.ItemList, item)
+	}
+	return response, nil
+}
+
+// getOnDemandPrice returns the cheapest on-demand price of the engine in the region, nil if not found.
+func getOnDemandPrice(instanceRegion *store.Region, engine client.EngineType) *comparePrice {
+	var onDemand *store.Term
+	for _, term := range instanceRegion.TermList {
+		if term.IsArchived() || term.DatabaseEngine != engine || term.Type != client.ChargeTypeOnDemand {
+			continue
+		}
+		if onDemand == nil || term.HourlyUSD < onDemand.HourlyUSD {
+			onDemand = term
+		}
+	}
+	if onDemand == nil {
+		return nil
+	}
+	return &comparePrice{
+		Code:          instanceRegion.Code,
+		Slug:          instanceRegion.Slug,
+		Name:          instanceRegion.Name,
+		TermCode:      onDemand.Code,
+		HourlyUSD:     onDemand.HourlyUSD,
+		MonthlyUSD:    onDemand.HourlyUSD * cost.MonthInHour,
+		USDPerCPUHour: onDemand.USDPerCPUHour,
+		USDPerGiBHour: onDemand.USDPerGiBHour,
+	}
+}
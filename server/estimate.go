@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+)
+
+// estimateRequest is the request of /v1/estimate, either the query parameters of GET or the JSON body of POST.
+type estimateRequest struct {
+	// Instance is the external ID of the instance, e.g. AWS:db.r6g.xlarge.
+	Instance string `json:"instance"`
+	// Region is the region code of the provider, e.g. us-east-1.
+	Region string            `json:"region"`
+	Engine client.EngineType `json:"engine"`
+	// Months is the horizon, 12 by default.
+	Months float64 `json:"months"`
+	// Utilization is the ratio of the horizon the instance is running, 1 by default.
+	Utilization *float64 `json:"utilization"`
+}
+
+// estimate returns the break-even analysis of the terms of the instance in the region over the horizon, the same as the breakeven command.
+func (s *Server) estimate(w http.ResponseWriter, r *http.Request) {
+	request := &estimateRequest{}
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body, %s", err)
+			return
+		}
+	} else {
+		values := r.URL.Query()
+		request.Instance = values.Get("instance")
+		request.Region = values.Get("region")
+		request.Engine = client.EngineType(values.Get("engine"))
+		var err error
+		if request.Months, err = getFloat(r, "months", 0); err != nil {
+			writeError(w, http.StatusBadRequest, "%s", err)
+			return
+		}
+		if values.Get("utilization") != "" {
+			utilization, err := getFloat(r, "utilization", 1)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "%s", err)
+				return
+			}
+			request.Utilization = &utilization
+		}
+	}
+
+	analysis, err := s.analyze(request)
+	if err != nil {
+		writeError(w, getStatus(err), "%s", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, analysis)
+}
+
+// analyze analyzes the terms of the request, with the horizon and the utilization defaulted.
+func (s *Server) analyze(request *estimateRequest) (*cost.Analysis, error) {
+	if request.Instance == "" || request.Region == "" || request.Engine == "" {
+		return nil, fmt.Errorf("instance, region and engine are required")
+	}
+	months := request.Months
+	if months == 0 {
+		months = 12
+	}
+	utilization := 1.0
+	if request.Utilization != nil {
+		utilization = *request.Utilization
+	}
+
+	dbInstance, ok := s.catalog.GetDBInstance(request.Instance)
+	if !ok {
+		return nil, &notFoundError{message: fmt.Sprintf("instance %s is not found", request.Instance)}
+	}
+	for _, instanceRegion := range dbInstance.RegionList {
+		if instanceRegion.Code == request.Region {
+			return cost.Analyze(instanceRegion, client.EngineType(strings.ToUpper(string(request.Engine))), cost.Months(months), utilization)
+		}
+	}
+	return nil, &notFoundError{message: fmt.Sprintf("instance %s is not provided in %s", request.Instance, request.Region)}
+}
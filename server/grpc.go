@@ -0,0 +1,328 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/cost"
+	dbcostv1 "github.com/bytebase/dbcost/proto/gen/dbcost/v1"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+	"github.com/bytebase/dbcost/taxonomy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// datasetVersionHeader is the header of the dataset version, the same as the ETag of the REST API.
+	datasetVersionHeader = "x-dataset-version"
+	// totalCountHeader is the header of the count of the matched items before pagination.
+	totalCountHeader = "x-total-count"
+)
+
+var (
+	engineToProto = map[client.EngineType]dbcostv1.DatabaseEngine{
+		client.EngineTypeMySQL:      dbcostv1.DatabaseEngine_DATABASE_ENGINE_MYSQL,
+		client.EngineTypePostgreSQL: dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+	}
+	engineFromProto = map[dbcostv1.DatabaseEngine]client.EngineType{
+		dbcostv1.DatabaseEngine_DATABASE_ENGINE_MYSQL:    client.EngineTypeMySQL,
+		dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES: client.EngineTypePostgreSQL,
+	}
+	chargeTypeToProto = map[client.ChargeType]dbcostv1.ChargeType{
+		client.ChargeTypeOnDemand: dbcostv1.ChargeType_CHARGE_TYPE_ON_DEMAND,
+		client.ChargeTypeReserved: dbcostv1.ChargeType_CHARGE_TYPE_RESERVED,
+	}
+	chargeTypeFromProto = map[dbcostv1.ChargeType]client.ChargeType{
+		dbcostv1.ChargeType_CHARGE_TYPE_ON_DEMAND: client.ChargeTypeOnDemand,
+		dbcostv1.ChargeType_CHARGE_TYPE_RESERVED:  client.ChargeTypeReserved,
+	}
+	sortFieldFromProto = map[dbcostv1.SortField]store.SortField{
+		dbcostv1.SortField_SORT_FIELD_UNSPECIFIED:       store.SortFieldNone,
+		dbcostv1.SortField_SORT_FIELD_HOURLY:            store.SortFieldHourly,
+		dbcostv1.SortField_SORT_FIELD_EFFECTIVE:         store.SortFieldEffective,
+		dbcostv1.SortField_SORT_FIELD_CPU_HOUR:          store.SortFieldCPUHour,
+		dbcostv1.SortField_SORT_FIELD_GIB_HOUR:          store.SortFieldGiBHour,
+		dbcostv1.SortField_SORT_FIELD_PRICE_PERFORMANCE: store.SortFieldPricePerformance,
+	}
+)
+
+// pricingService is the gRPC PricingService backed by the same catalog as the REST API.
+type pricingService struct {
+	dbcostv1.UnimplementedPricingServiceServer
+	server *Server
+}
+
+// NewGRPCServer returns the gRPC server of the PricingService with reflection enabled, sharing the catalog of the server.
+func NewGRPCServer(s *Server, optionList ...grpc.ServerOption) *grpc.Server {
+	grpcServer := grpc.NewServer(optionList...)
+	dbcostv1.RegisterPricingServiceServer(grpcServer, &pricingService{server: s})
+	reflection.Register(grpcServer)
+	return grpcServer
+}
+
+func (p *pricingService) ListInstances(request *dbcostv1.ListInstancesRequest, stream dbcostv1.PricingService_ListInstancesServer) error {
+	query, err := getQueryFromProto(request)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if request.Offset < 0 || request.Limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset and limit should not be negative, got %d and %d", request.Offset, request.Limit)
+	}
+
+	dbInstanceList := p.server.findDBInstance(query, taxonomy.Family(strings.ToUpper(request.Family)), taxonomy.Architecture(strings.ToUpper(request.Architecture)))
+	pagination := &page{Offset: int(request.Offset), Limit: int(request.Limit)}
+	if pagination.Limit == 0 {
+		pagination.Limit = len(dbInstanceList)
+	}
+	start, end := pagination.paginate(len(dbInstanceList))
+	if err := stream.SendHeader(p.getHeader(len(dbInstanceList))); err != nil {
+		return err
+	}
+	for _, dbInstance := range dbInstanceList[start:end] {
+		if err := stream.Send(&dbcostv1.ListInstancesResponse{DbInstance: convertDBInstance(dbInstance)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pricingService) GetInstance(ctx context.Context, request *dbcostv1.GetInstanceRequest) (*dbcostv1.GetInstanceResponse, error) {
+	dbInstance, ok := p.server.catalog.GetDBInstance(request.ExternalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "instance %s is not found", request.ExternalId)
+	}
+	if err := grpc.SetHeader(ctx, p.getHeader(-1)); err != nil {
+		return nil, err
+	}
+	return &dbcostv1.GetInstanceResponse{DbInstance: convertDBInstance(dbInstance)}, nil
+}
+
+func (p *pricingService) ListRegions(request *dbcostv1.ListRegionsRequest, stream dbcostv1.PricingService_ListRegionsServer) error {
+	regionList := p.server.listRegionSummary(strings.ToUpper(request.CloudProvider), region.Continent(strings.ToUpper(request.Continent)))
+	if err := stream.SendHeader(p.getHeader(len(regionList))); err != nil {
+		return err
+	}
+	for _, summary := range regionList {
+		if err := stream.Send(&dbcostv1.ListRegionsResponse{Region: &dbcostv1.RegionSummary{
+			CloudProvider: summary.CloudProvider,
+			Code:          summary.Code,
+			Slug:          summary.Slug,
+			Name:          summary.Name,
+			Geography:     summary.Geography,
+			Continent:     string(summary.Continent),
+			Latitude:      summary.Latitude,
+			Longitude:     summary.Longitude,
+			InstanceCount: int32(summary.InstanceCount),
+		}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pricingService) CompareInstances(ctx context.Context, request *dbcostv1.CompareInstancesRequest) (*dbcostv1.CompareInstancesResponse, error) {
+	comparison, err := p.server.compareDBInstance(request.ExternalIds, engineFromProto[request.DatabaseEngine], request.Region)
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	if err := grpc.SetHeader(ctx, p.getHeader(-1)); err != nil {
+		return nil, err
+	}
+
+	response := &dbcostv1.CompareInstancesResponse{DatabaseEngine: request.DatabaseEngine}
+	for _, item := range comparison.ItemList {
+		compareItem := &dbcostv1.CompareItem{
+			DbInstance: convertDBInstance(item.DBInstance),
+			Cheapest:   convertComparePrice(item.Cheapest),
+		}
+		for _, price := range item.PriceList {
+			compareItem.Prices = append(compareItem.Prices, convertComparePrice(price))
+		}
+		response.Items = append(response.Items, compareItem)
+	}
+	return response, nil
+}
+
+func (p *pricingService) Estimate(ctx context.Context, request *dbcostv1.EstimateRequest) (*dbcostv1.EstimateResponse, error) {
+	analysis, err := p.server.analyze(&estimateRequest{
+		Instance:    request.ExternalId,
+		Region:      request.RegionCode,
+		Engine:      engineFromProto[request.DatabaseEngine],
+		Months:      request.Months,
+		Utilization: request.Utilization,
+	})
+	if err != nil {
+		return nil, getGRPCError(err)
+	}
+	if err := grpc.SetHeader(ctx, p.getHeader(-1)); err != nil {
+		return nil, err
+	}
+
+	response := &dbcostv1.EstimateResponse{
+		DatabaseEngine: engineToProto[analysis.DatabaseEngine],
+		HorizonHours:   float64(analysis.Horizon),
+		Utilization:    analysis.Utilization,
+		OnDemand:       convertTermAnalysis(analysis.OnDemand),
+		Cheapest:       convertTermAnalysis(analysis.Cheapest),
+	}
+	for _, termAnalysis := range analysis.TermAnalysisList {
+		response.TermAnalyses = append(response.TermAnalyses, convertTermAnalysis(termAnalysis))
+	}
+	return response, nil
+}
+
+// getHeader returns the header of the response, with the total count if it is not negative.
+func (p *pricingService) getHeader(total int) metadata.MD {
+	header := metadata.Pairs(datasetVersionHeader, p.server.datasetVersion)
+	if total >= 0 {
+		header.Set(totalCountHeader, strconv.Itoa(total))
+	}
+	return header
+}
+
+// getGRPCError returns the gRPC status of the error.
+func getGRPCError(err error) error {
+	if _, ok := err.(*notFoundError); ok {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// getQueryFromProto converts the request to the catalog query, the same as getQuery of the REST API.
+func getQueryFromProto(request *dbcostv1.ListInstancesRequest) (*store.Query, error) {
+	sortBy, ok := sortFieldFromProto[request.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field %v", request.SortBy)
+	}
+	query := &store.Query{
+		CloudProvider:            strings.ToUpper(request.CloudProvider),
+		DatabaseEngine:           engineFromProto[request.DatabaseEngine],
+		ChargeType:               chargeTypeFromProto[request.ChargeType],
+		LeaseContractLength:      request.LeaseContractLength,
+		PurchaseOption:           request.PurchaseOption,
+		MinCPU:                   int(request.MinCpu),
+		MinMemory:                request.MinMemory,
+		Processor:                request.Processor,
+		MaxUSDPerCPUHour:         request.MaxUsdPerCpuHour,
+		MaxUSDPerGiBHour:         request.MaxUsdPerGibHour,
+		MaxPricePerformanceIndex: request.MaxPricePerformanceIndex,
+		IncludeArchived:          request.IncludeArchived,
+		SortBy:                   sortBy,
+		Descending:               request.Descending,
+	}
+	setQueryRegion(query, request.Region)
+	return query, nil
+}
+
+func convertDBInstance(dbInstance *store.DBInstance) *dbcostv1.DBInstance {
+	result := &dbcostv1.DBInstance{
+		Id:            int64(dbInstance.ID),
+		ExternalId:    dbInstance.GetExternalID(),
+		RowStatus:     string(dbInstance.RowStatus),
+		CreatorId:     int64(dbInstance.CreatorID),
+		UpdaterId:     int64(dbInstance.UpdaterID),
+		UpdatedTs:     dbInstance.UpdatedTs,
+		ArchivedTs:    dbInstance.ArchivedTs,
+		CloudProvider: dbInstance.CloudProvider,
+		Name:          dbInstance.Name,
+		Cpu:           int32(dbInstance.CPU),
+		Memory:        dbInstance.Memory,
+		Processor:     dbInstance.Processor,
+		Family:        string(dbInstance.Family),
+		Series:        dbInstance.Series,
+		Size:          dbInstance.Size,
+		SizeRank:      int32(dbInstance.SizeRank),
+		Architecture:  string(dbInstance.Architecture),
+	}
+	for _, instanceRegion := range dbInstance.RegionList {
+		result.Regions = append(result.Regions, convertRegion(instanceRegion))
+	}
+	for _, equivalent := range dbInstance.EquivalentList {
+		result.Equivalents = append(result.Equivalents, &dbcostv1.Equivalent{ExternalId: equivalent.ExternalID, Score: equivalent.Score})
+	}
+	return result
+}
+
+func convertRegion(instanceRegion *store.Region) *dbcostv1.Region {
+	result := &dbcostv1.Region{
+		Code:      instanceRegion.Code,
+		Slug:      instanceRegion.Slug,
+		Name:      instanceRegion.Name,
+		Geography: instanceRegion.Geography,
+		Continent: string(instanceRegion.Continent),
+		Latitude:  instanceRegion.Latitude,
+		Longitude: instanceRegion.Longitude,
+	}
+	for _, term := range instanceRegion.TermList {
+		result.Terms = append(result.Terms, convertTerm(term))
+	}
+	return result
+}
+
+func convertTerm(term *store.Term) *dbcostv1.Term {
+	result := &dbcostv1.Term{
+		Code:                  term.Code,
+		RowStatus:             string(term.RowStatus),
+		UpdatedTs:             term.UpdatedTs,
+		ArchivedTs:            term.ArchivedTs,
+		DatabaseEngine:        engineToProto[term.DatabaseEngine],
+		Type:                  chargeTypeToProto[term.Type],
+		HourlyUsd:             term.HourlyUSD,
+		CommitmentUsd:         term.CommitmentUSD,
+		UsdPerCpuHour:         term.USDPerCPUHour,
+		UsdPerGibHour:         term.USDPerGiBHour,
+		PricePerformanceIndex: term.PricePerformanceIndex,
+	}
+	if term.Payload != nil {
+		result.Payload = &dbcostv1.TermPayload{
+			LeaseContractLength: term.Payload.LeaseContractLength,
+			PurchaseOption:      term.Payload.PurchaseOption,
+		}
+	}
+	return result
+}
+
+func convertComparePrice(price *comparePrice) *dbcostv1.ComparePrice {
+	if price == nil {
+		return nil
+	}
+	return &dbcostv1.ComparePrice{
+		Code:          price.Code,
+		Slug:          price.Slug,
+		Name:          price.Name,
+		TermCode:      price.TermCode,
+		HourlyUsd:     price.HourlyUSD,
+		MonthlyUsd:    price.MonthlyUSD,
+		UsdPerCpuHour: price.USDPerCPUHour,
+		UsdPerGibHour: price.USDPerGiBHour,
+	}
+}
+
+func convertTermAnalysis(termAnalysis *cost.TermAnalysis) *dbcostv1.TermAnalysis {
+	if termAnalysis == nil {
+		return nil
+	}
+	estimate := termAnalysis.Estimate
+	return &dbcostv1.TermAnalysis{
+		Term: convertTerm(termAnalysis.Term),
+		Estimate: &dbcostv1.CostEstimate{
+			HorizonHours: float64(estimate.Horizon),
+			Utilization:  estimate.Utilization,
+			LeaseCount:   int32(estimate.LeaseCount),
+			UpfrontUsd:   estimate.UpfrontUSD,
+			RecurringUsd: estimate.RecurringUSD,
+			TotalUsd:     estimate.TotalUSD,
+			MonthlyUsd:   estimate.MonthlyUSD,
+		},
+		SavingUsd:      termAnalysis.SavingUSD,
+		SavingPercent:  termAnalysis.SavingPercent,
+		BreakEvenMonth: termAnalysis.BreakEvenMonth,
+	}
+}
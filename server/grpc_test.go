@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	dbcostv1 "github.com/bytebase/dbcost/proto/gen/dbcost/v1"
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func Test_GRPCServer(t *testing.T) {
+	dataset, err := store.LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+	s, err := NewServer(dataset)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := NewGRPCServer(s)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	defer grpcServer.Stop()
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := context.Background()
+	pricingClient := dbcostv1.NewPricingServiceClient(conn)
+
+	// the instances are streamed with the total count in the header.
+	stream, err := pricingClient.ListInstances(ctx, &dbcostv1.ListInstancesRequest{
+		SortBy:     dbcostv1.SortField_SORT_FIELD_HOURLY,
+		Descending: true,
+		Limit:      1,
+	})
+	require.NoError(t, err)
+	header, err := stream.Header()
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, header.Get(totalCountHeader))
+	require.Equal(t, []string{s.DatasetVersion()}, header.Get(datasetVersionHeader))
+	var externalIDList []string
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		externalIDList = append(externalIDList, response.DbInstance.ExternalId)
+	}
+	require.Equal(t, []string{"GCP:db-N1Standard-96-360"}, externalIDList)
+
+	// the instances are pruned to the matching regions and terms.
+	stream, err = pricingClient.ListInstances(ctx, &dbcostv1.ListInstancesRequest{
+		CloudProvider:  "aws",
+		DatabaseEngine: dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+		Region:         "us-east-1",
+		ChargeType:     dbcostv1.ChargeType_CHARGE_TYPE_RESERVED,
+	})
+	require.NoError(t, err)
+	response, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, response.DbInstance.Regions, 1)
+	for _, term := range response.DbInstance.Regions[0].Terms {
+		require.Equal(t, dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES, term.DatabaseEngine)
+		require.Equal(t, dbcostv1.ChargeType_CHARGE_TYPE_RESERVED, term.Type)
+		require.NotEmpty(t, term.Payload.LeaseContractLength)
+	}
+	_, err = stream.Recv()
+	require.ErrorIs(t, err, io.EOF)
+
+	// get the instance, with the dataset version in the header.
+	var getHeader metadata.MD
+	instance, err := pricingClient.GetInstance(ctx, &dbcostv1.GetInstanceRequest{ExternalId: "AWS:db.r6g.4xlarge"}, grpc.Header(&getHeader))
+	require.NoError(t, err)
+	require.Equal(t, int32(16), instance.DbInstance.Cpu)
+	require.Equal(t, "ARM64", instance.DbInstance.Architecture)
+	require.Len(t, instance.DbInstance.Regions, 2)
+	require.Equal(t, []string{s.DatasetVersion()}, getHeader.Get(datasetVersionHeader))
+	_, err = pricingClient.GetInstance(ctx, &dbcostv1.GetInstanceRequest{ExternalId: "AWS:db.x1.unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// regions.
+	regionStream, err := pricingClient.ListRegions(ctx, &dbcostv1.ListRegionsRequest{CloudProvider: "GCP"})
+	require.NoError(t, err)
+	regionResponse, err := regionStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "us-east4", regionResponse.Region.Code)
+	require.Equal(t, int32(1), regionResponse.Region.InstanceCount)
+
+	// compare with the equivalents.
+	comparison, err := pricingClient.CompareInstances(ctx, &dbcostv1.CompareInstancesRequest{
+		ExternalIds:    []string{"GCP:db-N1Standard-96-360"},
+		DatabaseEngine: dbcostv1.DatabaseEngine_DATABASE_ENGINE_MYSQL,
+	})
+	require.NoError(t, err)
+	require.Len(t, comparison.Items, 2)
+	require.Equal(t, "AWS:db.r6g.4xlarge", comparison.Items[1].DbInstance.ExternalId)
+	require.Equal(t, "us-east4", comparison.Items[0].Cheapest.Code)
+	_, err = pricingClient.CompareInstances(ctx, &dbcostv1.CompareInstancesRequest{ExternalIds: []string{"AWS:db.r6g.4xlarge"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// estimate, the utilization is 1 if unset.
+	estimate, err := pricingClient.Estimate(ctx, &dbcostv1.EstimateRequest{
+		ExternalId:     "AWS:db.r6g.4xlarge",
+		RegionCode:     "us-east-1",
+		DatabaseEngine: dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+		Months:         36,
+	})
+	require.NoError(t, err)
+	require.Equal(t, dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES, estimate.DatabaseEngine)
+	require.Equal(t, float64(1), estimate.Utilization)
+	require.Equal(t, dbcostv1.ChargeType_CHARGE_TYPE_ON_DEMAND, estimate.OnDemand.Term.Type)
+	require.NotEmpty(t, estimate.TermAnalyses)
+	require.LessOrEqual(t, estimate.Cheapest.Estimate.TotalUsd, estimate.OnDemand.Estimate.TotalUsd)
+	_, err = pricingClient.Estimate(ctx, &dbcostv1.EstimateRequest{
+		ExternalId:     "AWS:db.r6g.4xlarge",
+		RegionCode:     "eu-west-1",
+		DatabaseEngine: dbcostv1.DatabaseEngine_DATABASE_ENGINE_POSTGRES,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the service is discoverable by reflection.
+	reflectionStream, err := grpc_reflection_v1alpha.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, reflectionStream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+	}))
+	reflectionResponse, err := reflectionStream.Recv()
+	require.NoError(t, err)
+	var serviceList []string
+	for _, service := range reflectionResponse.GetListServicesResponse().Service {
+		serviceList = append(serviceList, service.Name)
+	}
+	require.Contains(t, serviceList, "dbcost.v1.PricingService")
+}
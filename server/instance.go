@@ -0,0 +1,226 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/store"
+	"github.com/bytebase/dbcost/taxonomy"
+)
+
+// listInstanceResponse is the response of GET /v1/instances.
+type listInstanceResponse struct {
+	page
+	DBInstanceList []*store.DBInstance `json:"dbInstanceList"`
+}
+
+// listInstance lists the instances with the regions and terms matching the query, see getQuery for the parameters.
+// The instances are ordered by their first matching term if sorted, and paginated by the instances.
+func (s *Server) listInstance(w http.ResponseWriter, r *http.Request) {
+	query, err := getQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+	p, err := getPage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "%s", err)
+		return
+	}
+	family := taxonomy.Family(strings.ToUpper(r.URL.Query().Get("family")))
+	architecture := taxonomy.Architecture(strings.ToUpper(r.URL.Query().Get("architecture")))
+
+	dbInstanceList := s.findDBInstance(query, family, architecture)
+	start, end := p.paginate(len(dbInstanceList))
+	writeJSON(w, http.StatusOK, &listInstanceResponse{
+		page:           *p,
+		DBInstanceList: dbInstanceList[start:end],
+	})
+}
+
+// findDBInstance returns the instances with the regions and terms matching the query, the family and the architecture.
+// The instances are pruned to the matching regions and terms, ordered by their first matching term.
+func (s *Server) findDBInstance(query *store.Query, family taxonomy.Family, architecture taxonomy.Architecture) []*store.DBInstance {
+	entryList, _ := s.catalog.Find(query)
+	var dbInstanceList []*store.DBInstance
+	dbInstanceMap := make(map[*store.DBInstance]*store.DBInstance)
+	regionMap := make(map[*store.Region]*store.Region)
+	for _, entry := range entryList {
+		if family != "" && entry.DBInstance.Family != family {
+			continue
+		}
+		if architecture != "" && entry.DBInstance.Architecture != architecture {
+			continue
+		}
+		dbInstance, ok := dbInstanceMap[entry.DBInstance]
+		if !ok {
+			instanceCopy := *entry.DBInstance
+			dbInstance = &instanceCopy
+			dbInstance.RegionList = nil
+			dbInstanceMap[entry.DBInstance] = dbInstance
+			dbInstanceList = append(dbInstanceList, dbInstance)
+		}
+		matchedRegion, ok := regionMap[entry.Region]
+		if !ok {
+			regionCopy := *entry.Region
+			matchedRegion = &regionCopy
+			matchedRegion.TermList = nil
+			regionMap[entry.Region] = matchedRegion
+			dbInstance.RegionList = append(dbInstance.RegionList, matchedRegion)
+		}
+		matchedRegion.TermList = append(matchedRegion.TermList, entry.Term)
+	}
+	return dbInstanceList
+}
+
+// getInstance returns the instance of /v1/instances/{provider}/{name}, e.g. /v1/instances/aws/db.r6g.xlarge.
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	provider, name, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/instances/"), "/")
+	if !ok || provider == "" || name == "" || strings.Contains(name, "/") {
+		writeError(w, http.StatusNotFound, "path %s is not found, should be /v1/instances/{provider}/{name}", r.URL.Path)
+		return
+	}
+	externalID := fmt.Sprintf("%s:%s", strings.ToUpper(provider), name)
+	dbInstance, ok := s.catalog.GetDBInstance(externalID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance %s is not found", externalID)
+		return
+	}
+	writeJSON(w, http.StatusOK, dbInstance)
+}
+
+// getQuery parses the catalog query of the request, the parameters are
+//   - provider, e.g. aws, case-insensitive.
+//   - engine, e.g. POSTGRES.
+//   - region, either the region code of the provider (e.g. us-east-1) or the canonical region slug (e.g. europe-frankfurt).
+//   - chargeType, OnDemand or Reserved; leaseContractLength, e.g. 1yr; purchaseOption, e.g. No Upfront.
+//   - minCpu, minMemory in GiB, processor.
+//   - maxUsdPerCpuHour, maxUsdPerGibHour, maxPricePerformanceIndex.
+//   - includeArchived.
+//   - sort, one of hourly, effective, cpu_hour, gib_hour and price_performance; desc.
+func getQuery(r *http.Request) (*store.Query, error) {
+	values := r.URL.Query()
+	query := &store.Query{
+		CloudProvider:       strings.ToUpper(values.Get("provider")),
+		DatabaseEngine:      client.EngineType(strings.ToUpper(values.Get("engine"))),
+		ChargeType:          client.ChargeType(values.Get("chargeType")),
+		LeaseContractLength: values.Get("leaseContractLength"),
+		PurchaseOption:      values.Get("purchaseOption"),
+		Processor:           values.Get("processor"),
+		SortBy:              store.SortField(strings.ToUpper(values.Get("sort"))),
+	}
+	setQueryRegion(query, values.Get("region"))
+	switch query.SortBy {
+	case store.SortFieldNone, store.SortFieldHourly, store.SortFieldEffective, store.SortFieldCPUHour, store.SortFieldGiBHour, store.SortFieldPricePerformance:
+	default:
+		return nil, fmt.Errorf("sort should be one of hourly, effective, cpu_hour, gib_hour and price_performance, got %q", values.Get("sort"))
+	}
+
+	var err error
+	if query.MinCPU, err = getInt(r, "minCpu"); err != nil {
+		return nil, err
+	}
+	if query.MinMemory, err = getFloat(r, "minMemory", 0); err != nil {
+		return nil, err
+	}
+	if query.MaxUSDPerCPUHour, err = getFloat(r, "maxUsdPerCpuHour", 0); err != nil {
+		return nil, err
+	}
+	if query.MaxUSDPerGiBHour, err = getFloat(r, "maxUsdPerGibHour", 0); err != nil {
+		return nil, err
+	}
+	if query.MaxPricePerformanceIndex, err = getFloat(r, "maxPricePerformanceIndex", 0); err != nil {
+		return nil, err
+	}
+	if query.IncludeArchived, err = getBool(r, "includeArchived"); err != nil {
+		return nil, err
+	}
+	if query.Descending, err = getBool(r, "desc"); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// setQueryRegion sets the region of the query, which is either the region code of the provider or the canonical region slug.
+func setQueryRegion(query *store.Query, code string) {
+	if code == "" {
+		return
+	}
+	if _, ok := region.Find(code); ok {
+		query.RegionSlug = code
+	} else {
+		query.RegionCode = code
+	}
+}
+
+// regionSummary is a region of a provider with the count of the instances provided in it.
+type regionSummary struct {
+	CloudProvider string           `json:"cloudProvider"`
+	Code          string           `json:"code"`
+	Slug          string           `json:"slug"`
+	Name          string           `json:"name"`
+	Geography     string           `json:"geography"`
+	Continent     region.Continent `json:"continent"`
+	Latitude      float64          `json:"latitude"`
+	Longitude     float64          `json:"longitude"`
+	InstanceCount int              `json:"instanceCount"`
+}
+
+// listRegionResponse is the response of GET /v1/regions.
+type listRegionResponse struct {
+	Total      int              `json:"total"`
+	RegionList []*regionSummary `json:"regionList"`
+}
+
+// listRegion lists the regions in the dataset ordered by the provider and the code, filtered by the provider and the continent.
+// Archived instances are not counted.
+func (s *Server) listRegion(w http.ResponseWriter, r *http.Request) {
+	provider := strings.ToUpper(r.URL.Query().Get("provider"))
+	continent := region.Continent(strings.ToUpper(r.URL.Query().Get("continent")))
+	regionList := s.listRegionSummary(provider, continent)
+	writeJSON(w, http.StatusOK, &listRegionResponse{Total: len(regionList), RegionList: regionList})
+}
+
+// listRegionSummary returns the regions of the provider in the continent, ordered by the provider and the code. Empty filters match all.
+func (s *Server) listRegionSummary(provider string, continent region.Continent) []*regionSummary {
+	regionMap := make(map[string]*regionSummary)
+	var regionList []*regionSummary
+	for _, dbInstance := range s.catalog.ListDBInstance() {
+		if dbInstance.IsArchived() || (provider != "" && dbInstance.CloudProvider != provider) {
+			continue
+		}
+		for _, instanceRegion := range dbInstance.RegionList {
+			if continent != "" && instanceRegion.Continent != continent {
+				continue
+			}
+			key := fmt.Sprintf("%s:%s", dbInstance.CloudProvider, instanceRegion.Code)
+			summary, ok := regionMap[key]
+			if !ok {
+				summary = &regionSummary{
+					CloudProvider: dbInstance.CloudProvider,
+					Code:          instanceRegion.Code,
+					Slug:          instanceRegion.Slug,
+					Name:          instanceRegion.Name,
+					Geography:     instanceRegion.Geography,
+					Continent:     instanceRegion.Continent,
+					Latitude:      instanceRegion.Latitude,
+					Longitude:     instanceRegion.Longitude,
+				}
+				regionMap[key] = summary
+				regionList = append(regionList, summary)
+			}
+			summary.InstanceCount++
+		}
+	}
+	sort.Slice(regionList, func(i, j int) bool {
+		if regionList[i].CloudProvider != regionList[j].CloudProvider {
+			return regionList[i].CloudProvider < regionList[j].CloudProvider
+		}
+		return regionList[i].Code < regionList[j].Code
+	})
+	return regionList
+}
@@ -0,0 +1,254 @@
+// Package server serves the pricing catalog over a REST API.
+package server
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bytebase/dbcost/store"
+)
+
+const (
+	// defaultLimit is the page size if the limit is not specified, the same as the frontend table.
+	defaultLimit = 50
+	// maxLimit is the max page size.
+	maxLimit = 1000
+)
+
+// openAPISpec is the OpenAPI spec of the API, served at /v1/openapi.json.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// Server serves the dataset over the REST API, the dataset is immutable once served.
+type Server struct {
+	catalog *store.Catalog
+	// datasetVersion is derived from the content of the dataset, it is used as the ETag of all the responses.
+	datasetVersion string
+	mux            *http.ServeMux
+}
+
+// NewServer returns the server of the dataset.
+func NewServer(dataset *store.Dataset) (*Server, error) {
+	dataByted, err := json.Marshal(dataset)
+	if err != nil {
+		return nil, fmt.Errorf("Fail to marshal the dataset, [internal]: %v", err)
+	}
+	checksum := sha256.Sum256(dataByted)
+	s := &Server{
+		catalog:        store.NewCatalog(dataset.DBInstanceList),
+		datasetVersion: hex.EncodeToString(checksum[:8]),
+		mux:            http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/v1/instances", s.withCache(s.listInstance))
+	s.mux.HandleFunc("/v1/instances/", s.withCache(s.getInstance))
+	s.mux.HandleFunc("/v1/regions", s.withCache(s.listRegion))
+	s.mux.HandleFunc("/v1/compare", s.withCache(s.compare))
+	cachedEstimate := s.withCache(s.estimate)
+	s.mux.HandleFunc("/v1/estimate", func(w http.ResponseWriter, r *http.Request) {
+		// the POST body is the same as the GET query parameters, and it is not cached.
+		if r.Method == http.MethodPost {
+			s.estimate(w, r)
+			return
+		}
+		cachedEstimate(w, r)
+	})
+	s.mux.HandleFunc("/v1/openapi.json", s.withCache(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPISpec)
+	}))
+	return s, nil
+}
+
+// Handle registers an additional handler, e.g. the pricing GraphQL API.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// DatasetVersion returns the version of the dataset served.
+func (s *Server) DatasetVersion() string {
+	return s.datasetVersion
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// withCache only allows the read methods, and sets the ETag of the dataset version on the successful responses.
+// The responses only depend on the request and the dataset, so a valid request with a matching If-None-Match is replied with 304.
+// The request is validated by the handler first, so the errors are neither cached nor replied with 304.
+func (s *Server) withCache(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "method %s is not allowed", r.Method)
+			return
+		}
+		etag := fmt.Sprintf(`"%s"`, s.datasetVersion)
+		cw := &cacheResponseWriter{ResponseWriter: w, etag: etag}
+		if match := r.Header.Get("If-None-Match"); match != "" {
+			for _, candidate := range strings.Split(match, ",") {
+				if candidate = strings.TrimSpace(candidate); candidate == etag || candidate == "W/"+etag || candidate == "*" {
+					cw.matched = true
+					break
+				}
+			}
+		}
+		handler(cw, r)
+	}
+}
+
+// cacheResponseWriter sets the caching headers once the handler replies with 2xx,
+// and replaces the response with 304 if the request matches the ETag.
+type cacheResponseWriter struct {
+	http.ResponseWriter
+	etag string
+	// matched is true if the If-None-Match of the request matches the ETag.
+	matched     bool
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *cacheResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if status >= http.StatusOK && status < http.StatusMultipleChoices {
+		w.Header().Set("ETag", w.etag)
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		if w.matched {
+			w.notModified = true
+			w.Header().Del("Content-Type")
+			status = http.StatusNotModified
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	// the body of 304 is discarded.
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// errorResponse is the body of the error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// notFoundError is the error of a resource not found, the other errors are caused by the invalid requests.
+type notFoundError struct {
+	message string
+}
+
+func (e *notFoundError) Error() string {
+	return e.message
+}
+
+// getStatus returns the HTTP status of the error.
+func getStatus(err error) int {
+	if _, ok := err.(*notFoundError); ok {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+func writeError(w http.ResponseWriter, status int, format string, a ...interface{}) {
+	writeJSON(w, status, &errorResponse{Error: fmt.Sprintf(format, a...)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// page is the pagination of the list responses.
+type page struct {
+	Total  int `json:"total"`
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+// getPage parses the offset and the limit of the request.
+func getPage(r *http.Request) (*page, error) {
+	p := &page{Limit: defaultLimit}
+	var err error
+	if p.Offset, err = getInt(r, "offset"); err != nil {
+		return nil, err
+	}
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if p.Limit, err = getInt(r, "limit"); err != nil {
+			return nil, err
+		}
+	}
+	if p.Offset < 0 {
+		return nil, fmt.Errorf("offset should not be negative, got %d", p.Offset)
+	}
+	if p.Limit <= 0 || p.Limit > maxLimit {
+		return nil, fmt.Errorf("limit should be between 1 and %d, got %d", maxLimit, p.Limit)
+	}
+	return p, nil
+}
+
+// paginate returns the range [start, end) of the page within total.
+func (p *page) paginate(total int) (int, int) {
+	p.Total = total
+	start := p.Offset
+	if start > total {
+		start = total
+	}
+	end := start + p.Limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func getInt(r *http.Request, key string) (int, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s should be an integer, got %q", key, value)
+	}
+	return n, nil
+}
+
+func getFloat(r *http.Request, key string, defaultValue float64) (float64, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s should be a number, got %q", key, value)
+	}
+	return f, nil
+}
+
+func getBool(r *http.Request, key string) (bool, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s should be a boolean, got %q", key, value)
+	}
+	return b, nil
+}
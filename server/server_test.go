@@ -0,0 +1,126 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytebase/dbcost/cost"
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Server(t *testing.T) {
+	dataset, err := store.LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+	s, err := NewServer(dataset)
+	require.NoError(t, err)
+
+	do := func(method, target string, body string, header map[string]string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		for key, value := range header {
+			request.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, request)
+		return recorder
+	}
+	get := func(target string, v interface{}) {
+		recorder := do(http.MethodGet, target, "", nil)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), v))
+	}
+
+	// list with filters, the instances are pruned to the matched regions and terms.
+	instanceList := &listInstanceResponse{}
+	get("/v1/instances?provider=aws&engine=postgres&region=us-east-1&chargeType=OnDemand", instanceList)
+	require.Equal(t, 1, instanceList.Total)
+	require.Equal(t, "AWS:db.r6g.4xlarge", instanceList.DBInstanceList[0].ExternalID)
+	require.Len(t, instanceList.DBInstanceList[0].RegionList, 1)
+	for _, term := range instanceList.DBInstanceList[0].RegionList[0].TermList {
+		require.Equal(t, "POSTGRES", string(term.DatabaseEngine))
+		require.Equal(t, "OnDemand", string(term.Type))
+	}
+
+	// pagination and sorting.
+	get("/v1/instances?sort=hourly&desc=true&limit=1", instanceList)
+	require.Equal(t, 2, instanceList.Total)
+	require.Equal(t, 1, instanceList.Limit)
+	require.Len(t, instanceList.DBInstanceList, 1)
+	require.Equal(t, "GCP:db-N1Standard-96-360", instanceList.DBInstanceList[0].ExternalID)
+	get("/v1/instances?sort=hourly&desc=true&limit=1&offset=1", instanceList)
+	require.Equal(t, "AWS:db.r6g.4xlarge", instanceList.DBInstanceList[0].ExternalID)
+	get("/v1/instances?offset=10", instanceList)
+	require.Empty(t, instanceList.DBInstanceList)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/v1/instances?limit=0", "", nil).Code)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/v1/instances?sort=name", "", nil).Code)
+
+	// get by the provider and the name.
+	dbInstance := &store.DBInstance{}
+	get("/v1/instances/aws/db.r6g.4xlarge", dbInstance)
+	require.Equal(t, "AWS:db.r6g.4xlarge", dbInstance.ExternalID)
+	require.Len(t, dbInstance.RegionList, 2)
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v1/instances/aws/db.x1.unknown", "", nil).Code)
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v1/instances/aws", "", nil).Code)
+
+	// regions.
+	regionList := &listRegionResponse{}
+	get("/v1/regions?provider=aws", regionList)
+	require.Equal(t, 2, regionList.Total)
+	require.Equal(t, "ap-south-1", regionList.RegionList[0].Code)
+	require.Equal(t, 1, regionList.RegionList[0].InstanceCount)
+
+	// compare a single instance with its equivalents.
+	comparison := &compareResponse{}
+	get("/v1/compare?instance=AWS:db.r6g.4xlarge&engine=MYSQL", comparison)
+	require.Len(t, comparison.ItemList, 2)
+	require.Equal(t, "GCP:db-N1Standard-96-360", comparison.ItemList[1].DBInstance.ExternalID)
+	require.Nil(t, comparison.ItemList[0].DBInstance.RegionList)
+	require.NotNil(t, comparison.ItemList[1].Cheapest)
+	require.InDelta(t, comparison.ItemList[1].Cheapest.HourlyUSD*cost.MonthInHour, comparison.ItemList[1].Cheapest.MonthlyUSD, 1e-9)
+	require.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/v1/compare?instance=AWS:db.r6g.4xlarge", "", nil).Code)
+
+	// estimate by GET and POST.
+	analysis := &cost.Analysis{}
+	get("/v1/estimate?instance=AWS:db.r6g.4xlarge&region=us-east-1&engine=POSTGRES&months=36", analysis)
+	require.Equal(t, cost.Months(36), analysis.Horizon)
+	require.NotNil(t, analysis.Cheapest)
+	recorder := do(http.MethodPost, "/v1/estimate", `{"instance": "AWS:db.r6g.4xlarge", "region": "us-east-1", "engine": "POSTGRES", "utilization": 0.5}`, nil)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Empty(t, recorder.Header().Get("ETag"))
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), analysis))
+	require.Equal(t, cost.Months(12), analysis.Horizon)
+	require.Equal(t, 0.5, analysis.Utilization)
+	require.Equal(t, http.StatusNotFound, do(http.MethodGet, "/v1/estimate?instance=AWS:db.r6g.4xlarge&region=eu-west-1&engine=POSTGRES", "", nil).Code)
+
+	// the ETag is the dataset version.
+	recorder = do(http.MethodGet, "/v1/regions", "", nil)
+	etag := recorder.Header().Get("ETag")
+	require.Equal(t, `"`+s.DatasetVersion()+`"`, etag)
+	require.Equal(t, http.StatusNotModified, do(http.MethodGet, "/v1/instances?provider=gcp", "", map[string]string{"If-None-Match": etag}).Code)
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/instances", "", map[string]string{"If-None-Match": `"stale"`}).Code)
+	require.Equal(t, http.StatusMethodNotAllowed, do(http.MethodDelete, "/v1/instances", "", nil).Code)
+
+	// the errors are not cached, even if the request matches the ETag.
+	recorder = do(http.MethodGet, "/v1/instances?limit=0", "", map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, recorder.Header().Get("ETag"))
+	require.Empty(t, recorder.Header().Get("Cache-Control"))
+	recorder = do(http.MethodGet, "/v1/instances/aws/db.x1.unknown", "", nil)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+	require.Empty(t, recorder.Header().Get("ETag"))
+	recorder = do(http.MethodGet, "/v1/regions", "", map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+	require.Empty(t, recorder.Body.String())
+
+	// the OpenAPI spec documents all the paths.
+	spec := map[string]interface{}{}
+	get("/v1/openapi.json", &spec)
+	paths, ok := spec["paths"].(map[string]interface{})
+	require.True(t, ok)
+	for _, path := range []string{"/v1/instances", "/v1/instances/{provider}/{name}", "/v1/regions", "/v1/compare", "/v1/estimate"} {
+		require.Contains(t, paths, path)
+	}
+}
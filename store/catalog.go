@@ -0,0 +1,241 @@
+package store
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+)
+
+// CatalogEntry is a price point of the catalog, that is a term of an instance in a region.
+type CatalogEntry struct {
+	DBInstance *DBInstance
+	Region     *Region
+	Term       *Term
+}
+
+// SortField is the field to sort the query result by.
+type SortField string
+
+const (
+	// SortFieldNone keeps the order of the catalog, that is the order of instances, regions and terms.
+	SortFieldNone SortField = ""
+	// SortFieldHourly sorts the entries by the hourly price.
+	SortFieldHourly SortField = "HOURLY"
+	// SortFieldEffective sorts the entries by the effective hourly price, with the commitment amortized.
+	SortFieldEffective SortField = "EFFECTIVE"
+	// SortFieldCPUHour sorts the entries by the price per vCPU-hour.
+	SortFieldCPUHour SortField = "CPU_HOUR"
+	// SortFieldGiBHour sorts the entries by the price per GiB-hour.
+	SortFieldGiBHour SortField = "GIB_HOUR"
+	// SortFieldPricePerformance sorts the entries by the price-performance index.
+	SortFieldPricePerformance SortField = "PRICE_PERFORMANCE"
+)
+
+// Query is the query of the catalog, empty fields match all.
+type Query struct {
+	CloudProvider  string
+	DatabaseEngine client.EngineType
+	// RegionCode is the region code used by the provider, e.g. us-east-1.
+	RegionCode string
+	// RegionSlug is the slug of the canonical region, e.g. europe-frankfurt.
+	RegionSlug          string
+	ChargeType          client.ChargeType
+	LeaseContractLength string
+	PurchaseOption      string
+	MinCPU              int
+	// MinMemory is the min memory in GiB.
+	MinMemory float64
+	// Processor matches the processor of the instance case-insensitively, e.g. graviton.
+	Processor string
+	// MaxUSDPerCPUHour, MaxUSDPerGiBHour and MaxPricePerformanceIndex filter the entries by the price-per-unit metrics, 0 matches all.
+	MaxUSDPerCPUHour         float64
+	MaxUSDPerGiBHour         float64
+	MaxPricePerformanceIndex float64
+	// IncludeArchived includes the instances and terms no longer provided upstream.
+	IncludeArchived bool
+
+	SortBy     SortField
+	Descending bool
+	// Offset and Limit paginate the result, a zero Limit returns all the entries after Offset.
+	Offset int
+	Limit  int
+}
+
+// Catalog is an in-memory indexed dbInstance list.
+type Catalog struct {
+	dbInstanceList []*DBInstance
+	entryList      []*CatalogEntry
+
+	externalIDMap map[string]*DBInstance
+	// entry indexes, the value is the index of the entryList.
+	providerIndex   map[string][]int
+	regionCodeIndex map[string][]int
+	regionSlugIndex map[string][]int
+}
+
+// NewCatalog builds a catalog from the dbInstanceList.
+func NewCatalog(dbInstanceList []*DBInstance) *Catalog {
+	c := &Catalog{
+		dbInstanceList:  dbInstanceList,
+		externalIDMap:   make(map[string]*DBInstance),
+		providerIndex:   make(map[string][]int),
+		regionCodeIndex: make(map[string][]int),
+		regionSlugIndex: make(map[string][]int),
+	}
+	for _, dbInstance := range dbInstanceList {
+		c.externalIDMap[dbInstance.GetExternalID()] = dbInstance
+		for _, region := range dbInstance.RegionList {
+			for _, term := range region.TermList {
+				idx := len(c.entryList)
+				c.entryList = append(c.entryList, &CatalogEntry{DBInstance: dbInstance, Region: region, Term: term})
+				c.providerIndex[dbInstance.CloudProvider] = append(c.providerIndex[dbInstance.CloudProvider], idx)
+				c.regionCodeIndex[region.Code] = append(c.regionCodeIndex[region.Code], idx)
+				if region.Slug != "" {
+					c.regionSlugIndex[region.Slug] = append(c.regionSlugIndex[region.Slug], idx)
+				}
+			}
+		}
+	}
+	return c
+}
+
+// LoadCatalog loads the catalog from local .json file saved by Save.
+func LoadCatalog(filePath string) (*Catalog, error) {
+	dbInstanceList, err := Load(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalog(dbInstanceList), nil
+}
+
+// ListDBInstance returns all the instances in the catalog.
+func (c *Catalog) ListDBInstance() []*DBInstance {
+	return c.dbInstanceList
+}
+
+// GetDBInstance returns the instance with the given external ID, e.g. AWS:db.r6g.xlarge.
+func (c *Catalog) GetDBInstance(externalID string) (*DBInstance, bool) {
+	dbInstance, ok := c.externalIDMap[externalID]
+	return dbInstance, ok
+}
+
+// Find returns the entries matching the query, and the total count of the matched entries before pagination.
+func (c *Catalog) Find(query *Query) ([]*CatalogEntry, int) {
+	var matchedList []*CatalogEntry
+	for _, idx := range c.getCandidate(query) {
+		entry := c.entryList[idx]
+		if query.match(entry) {
+			matchedList = append(matchedList, entry)
+		}
+	}
+
+	if query.SortBy != SortFieldNone {
+		getPrice := func(entry *CatalogEntry) float64 {
+			switch query.SortBy {
+			case SortFieldEffective:
+				return entry.Term.GetEffectiveHourlyUSD()
+			case SortFieldCPUHour:
+				return entry.Term.USDPerCPUHour
+			case SortFieldGiBHour:
+				return entry.Term.USDPerGiBHour
+			case SortFieldPricePerformance:
+				return entry.Term.PricePerformanceIndex
+			}
+			return entry.Term.HourlyUSD
+		}
+		sort.SliceStable(matchedList, func(i, j int) bool {
+			if query.Descending {
+				return getPrice(matchedList[i]) > getPrice(matchedList[j])
+			}
+			return getPrice(matchedList[i]) < getPrice(matchedList[j])
+		})
+	}
+
+	total := len(matchedList)
+	if query.Offset >= total {
+		return nil, total
+	}
+	matchedList = matchedList[query.Offset:]
+	if query.Limit > 0 && query.Limit < len(matchedList) {
+		matchedList = matchedList[:query.Limit]
+	}
+	return matchedList, total
+}
+
+// getCandidate returns the smallest indexed candidate list of the query.
+func (c *Catalog) getCandidate(query *Query) []int {
+	var candidateList []int
+	isIndexed := false
+	useIndex := func(indexList []int) {
+		if !isIndexed || len(indexList) < len(candidateList) {
+			candidateList = indexList
+		}
+		isIndexed = true
+	}
+	if query.CloudProvider != "" {
+		useIndex(c.providerIndex[query.CloudProvider])
+	}
+	if query.RegionCode != "" {
+		useIndex(c.regionCodeIndex[query.RegionCode])
+	}
+	if query.RegionSlug != "" {
+		useIndex(c.regionSlugIndex[query.RegionSlug])
+	}
+	if isIndexed {
+		return candidateList
+	}
+
+	candidateList = make([]int, len(c.entryList))
+	for i := range c.entryList {
+		candidateList[i] = i
+	}
+	return candidateList
+}
+
+func (q *Query) match(entry *CatalogEntry) bool {
+	dbInstance, region, term := entry.DBInstance, entry.Region, entry.Term
+	if !q.IncludeArchived && (dbInstance.IsArchived() || term.IsArchived()) {
+		return false
+	}
+	if q.CloudProvider != "" && q.CloudProvider != dbInstance.CloudProvider {
+		return false
+	}
+	if q.RegionCode != "" && q.RegionCode != region.Code {
+		return false
+	}
+	if q.RegionSlug != "" && q.RegionSlug != region.Slug {
+		return false
+	}
+	if q.DatabaseEngine != "" && q.DatabaseEngine != term.DatabaseEngine {
+		return false
+	}
+	if q.ChargeType != "" && q.ChargeType != term.Type {
+		return false
+	}
+	if q.LeaseContractLength != "" && (term.Payload == nil || q.LeaseContractLength != term.Payload.LeaseContractLength) {
+		return false
+	}
+	if q.PurchaseOption != "" && (term.Payload == nil || q.PurchaseOption != term.Payload.PurchaseOption) {
+		return false
+	}
+	if q.MinCPU > 0 && dbInstance.CPU < q.MinCPU {
+		return false
+	}
+	if q.MinMemory > 0 && dbInstance.GetMemoryGiB() < q.MinMemory {
+		return false
+	}
+	if q.Processor != "" && !strings.Contains(strings.ToLower(dbInstance.Processor), strings.ToLower(q.Processor)) {
+		return false
+	}
+	if q.MaxUSDPerCPUHour > 0 && term.USDPerCPUHour > q.MaxUSDPerCPUHour {
+		return false
+	}
+	if q.MaxUSDPerGiBHour > 0 && term.USDPerGiBHour > q.MaxUSDPerGiBHour {
+		return false
+	}
+	if q.MaxPricePerformanceIndex > 0 && term.PricePerformanceIndex > q.MaxPricePerformanceIndex {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Catalog(t *testing.T) {
+	catalog, err := LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+	require.Len(t, catalog.ListDBInstance(), 2)
+
+	dbInstance, ok := catalog.GetDBInstance("GCP:db-N1Standard-96-360")
+	require.True(t, ok)
+	require.Equal(t, 96, dbInstance.CPU)
+
+	entryList, total := catalog.Find(&Query{
+		CloudProvider:  CloudProviderAWS,
+		DatabaseEngine: client.EngineTypePostgreSQL,
+		RegionCode:     "us-east-1",
+		ChargeType:     client.ChargeTypeOnDemand,
+	})
+	require.Equal(t, 1, total)
+	require.Equal(t, 2.158, entryList[0].Term.HourlyUSD)
+
+	entryList, total = catalog.Find(&Query{
+		DatabaseEngine:      client.EngineTypeMySQL,
+		LeaseContractLength: "3yr",
+		Processor:           "graviton",
+		MinCPU:              16,
+		MinMemory:           128,
+		SortBy:              SortFieldEffective,
+	})
+	require.Equal(t, 4, total)
+	for i := 1; i < len(entryList); i++ {
+		require.LessOrEqual(t, entryList[i-1].Term.GetEffectiveHourlyUSD(), entryList[i].Term.GetEffectiveHourlyUSD())
+	}
+
+	// pagination
+	entryList, total = catalog.Find(&Query{SortBy: SortFieldHourly, Descending: true, Offset: 1, Limit: 2})
+	require.Equal(t, 25, total)
+	require.Len(t, entryList, 2)
+	require.Equal(t, 2.158, entryList[0].Term.HourlyUSD)
+
+	entryList, total = catalog.Find(&Query{MinMemory: 512})
+	require.Equal(t, 0, total)
+	require.Empty(t, entryList)
+}
+
+func Test_CatalogUnitPrice(t *testing.T) {
+	catalog, err := LoadCatalog("../data/sample.json")
+	require.NoError(t, err)
+
+	entryList, total := catalog.Find(&Query{SortBy: SortFieldPricePerformance})
+	require.Equal(t, 25, total)
+	for i := 1; i < len(entryList); i++ {
+		require.LessOrEqual(t, entryList[i-1].Term.PricePerformanceIndex, entryList[i].Term.PricePerformanceIndex)
+	}
+
+	entryList, _ = catalog.Find(&Query{SortBy: SortFieldCPUHour, Descending: true})
+	for i := 1; i < len(entryList); i++ {
+		require.GreaterOrEqual(t, entryList[i-1].Term.USDPerCPUHour, entryList[i].Term.USDPerCPUHour)
+	}
+
+	// the on-demand PostgreSQL in us-east-1 costs 2.158 per hour with 16 vCPU and 128 GiB.
+	entryList, total = catalog.Find(&Query{MaxUSDPerCPUHour: 0.1, MaxUSDPerGiBHour: 0.01, SortBy: SortFieldGiBHour})
+	require.NotZero(t, total)
+	for _, entry := range entryList {
+		require.LessOrEqual(t, entry.Term.USDPerCPUHour, 0.1)
+		require.LessOrEqual(t, entry.Term.USDPerGiBHour, 0.01)
+	}
+	entryList, _ = catalog.Find(&Query{CloudProvider: CloudProviderAWS, RegionCode: "us-east-1", DatabaseEngine: client.EngineTypePostgreSQL, ChargeType: client.ChargeTypeOnDemand})
+	term := entryList[0].Term
+	require.InDelta(t, 2.158/16, term.USDPerCPUHour, 1e-8)
+	require.InDelta(t, 2.158/128, term.USDPerGiBHour, 1e-8)
+	require.InDelta(t, 2.158/((16+128/4)/2), term.PricePerformanceIndex, 1e-8)
+}
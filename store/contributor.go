@@ -1,3 +1,5 @@
 package store
 
+// SYSTEM_BOT is the contributor of the rows seeded from upstream,
+// human contributors correcting the upstream data via overrides have their own IDs.
 const SYSTEM_BOT = 0
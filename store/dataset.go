@@ -0,0 +1,96 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Source is the provenance of the data fetched from a provider.
+type Source struct {
+	CloudProvider string `json:"cloudProvider"`
+	// URL is the endpoint the data is fetched from, credentials are excluded.
+	URL string `json:"url"`
+	// Version and PublicationDate are the version of the offer file provided by AWS.
+	Version         string `json:"version,omitempty"`
+	PublicationDate string `json:"publicationDate,omitempty"`
+	// PageCount is the number of pages fetched from GCP.
+	PageCount     int   `json:"pageCount,omitempty"`
+	FetchedTs     int64 `json:"fetchedTs"`
+	OfferCount    int   `json:"offerCount"`
+	InstanceCount int   `json:"instanceCount"`
+}
+
+// Metadata is the header of the dataset that records where the data came from.
+type Metadata struct {
+	GeneratedTs int64 `json:"generatedTs"`
+	// Version is the version of dbcost generating the dataset.
+	Version    string    `json:"version"`
+	SourceList []*Source `json:"sourceList"`
+	// OverrideCount is the number of manual overrides applied.
+	OverrideCount int `json:"overrideCount"`
+	InstanceCount int `json:"instanceCount"`
+}
+
+// GetSource returns the source of the given provider, nil if not found.
+func (m *Metadata) GetSource(cloudProvider CloudProvider) *Source {
+	for _, source := range m.SourceList {
+		if source.CloudProvider == cloudProvider.String() {
+			return source
+		}
+	}
+	return nil
+}
+
+// Dataset is the persisted dbInstance list with its header.
+type Dataset struct {
+	// SchemaVersion is the version of the schema the dataset conforms to, 0 if saved before the schema was introduced.
+	SchemaVersion  int           `json:"schemaVersion"`
+	Metadata       *Metadata     `json:"metadata"`
+	DBInstanceList []*DBInstance `json:"dbInstanceList"`
+}
+
+// Save save the dataset to local .json file atomically, with the compressed variants next to it if any.
+// The dataset is stamped with the current SchemaVersion.
+func Save(dataset *Dataset, filePath string, compressionList ...Compression) error {
+	dataset.SchemaVersion = SchemaVersion
+	// the output is indented so that the diff of each run is line-wise.
+	dataByted, err := json.MarshalIndent(dataset, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(filePath, dataByted, compressionList)
+}
+
+// LoadDataset loads the dataset from local .json file saved by Save, or its .gz or .br variant.
+// Files saved before the header was introduced (a bare dbInstance list) are loaded with a nil Metadata.
+func LoadDataset(filePath string) (*Dataset, error) {
+	dataByted, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if dataByted, err = decompress(filePath, dataByted); err != nil {
+		return nil, fmt.Errorf("Fail to decompress the file %s, [internal]: %v", filePath, err)
+	}
+
+	dataset := &Dataset{}
+	if bytes.HasPrefix(bytes.TrimSpace(dataByted), []byte("[")) {
+		err = json.Unmarshal(dataByted, &dataset.DBInstanceList)
+	} else {
+		err = json.Unmarshal(dataByted, dataset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the file %s, [internal]: %v", filePath, err)
+	}
+	return dataset, nil
+}
+
+// Load loads DBInstanceList from local .json file saved by Save.
+func Load(filePath string) ([]*DBInstance, error) {
+	dataset, err := LoadDataset(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return dataset.DBInstanceList, nil
+}
@@ -0,0 +1,56 @@
+package store
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dataset(t *testing.T) {
+	dataset, err := LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+	require.NotNil(t, dataset.Metadata)
+	require.Equal(t, "20221026224341", dataset.Metadata.GetSource(CloudProviderAWS).Version)
+	require.Len(t, dataset.DBInstanceList, dataset.Metadata.InstanceCount)
+
+	filePath := path.Join(t.TempDir(), "dbInstance.json")
+	require.NoError(t, Save(dataset, filePath))
+	saved, err := LoadDataset(filePath)
+	require.NoError(t, err)
+	require.Equal(t, dataset, saved)
+
+	// files saved before the header was introduced.
+	legacyFilePath := path.Join(t.TempDir(), "legacy.json")
+	require.NoError(t, os.WriteFile(legacyFilePath, []byte(`[{"id": 0, "cloudProvider": "AWS", "name": "db.m5.large"}]`), 0644))
+	legacy, err := LoadDataset(legacyFilePath)
+	require.NoError(t, err)
+	require.Nil(t, legacy.Metadata)
+	require.Len(t, legacy.DBInstanceList, 1)
+	require.Equal(t, "AWS:db.m5.large", legacy.DBInstanceList[0].GetExternalID())
+}
+
+func Test_SaveCompressed(t *testing.T) {
+	dataset, err := LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+
+	dirPath := t.TempDir()
+	filePath := path.Join(dirPath, "dbInstance.json")
+	require.NoError(t, Save(dataset, filePath, CompressionGzip, CompressionBrotli))
+	for _, variant := range []string{filePath, filePath + ".gz", filePath + ".br"} {
+		saved, err := LoadDataset(variant)
+		require.NoError(t, err)
+		require.Equal(t, dataset, saved)
+	}
+
+	// no temp file is left behind.
+	entryList, err := os.ReadDir(dirPath)
+	require.NoError(t, err)
+	require.Len(t, entryList, 3)
+
+	// the previous file is kept intact if the save fails.
+	require.Error(t, Save(dataset, path.Join(dirPath, "missing", "dbInstance.json")))
+	_, err = ParseCompressionList("gzip,zstd")
+	require.Error(t, err)
+}
@@ -1,12 +1,13 @@
 package store
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
 
 	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/taxonomy"
 )
 
 // TermPayload is the payload of the term
@@ -21,27 +22,118 @@ type TermPayload struct {
 type Term struct {
 	Code string `json:"code"`
 
+	// system fields, maintained by Merge.
+	RowStatus RowStatus `json:"rowStatus"`
+	UpdatedTs int64     `json:"updatedTs"`
+	// ArchivedTs is the time the term is no longer provided upstream, 0 if the term is not archived.
+	ArchivedTs int64 `json:"archivedTs"`
+
 	DatabaseEngine client.EngineType `json:"databaseEngine"`
 	Type           client.ChargeType `json:"type"`
 	Payload        *TermPayload      `json:"payload"`
 
 	HourlyUSD     float64 `json:"hourlyUSD"`
 	CommitmentUSD float64 `json:"commitmentUSD"`
+
+	// price-per-unit metrics, filled in by FillUnitPrice.
+	USDPerCPUHour         float64 `json:"usdPerCPUHour"`
+	USDPerGiBHour         float64 `json:"usdPerGiBHour"`
+	PricePerformanceIndex float64 `json:"pricePerformanceIndex"`
+}
+
+const (
+	// YearInHour is the hours of a year, the same as the frontend.
+	YearInHour = 365 * 24
+	// MonthInHour is the hours of a month, that is 1/12 of a year.
+	MonthInHour = YearInHour / 12
+)
+
+// GetName returns the name of the term, e.g. OnDemand, Reserved 1yr No Upfront.
+func (t *Term) GetName() string {
+	if t.Payload == nil {
+		return string(t.Type)
+	}
+	return fmt.Sprintf("%s %s %s", t.Type, t.Payload.LeaseContractLength, t.Payload.PurchaseOption)
+}
+
+// GetLeaseYear returns the lease contract length of the term in years, 0 if the term is charged on demand.
+func (t *Term) GetLeaseYear() int {
+	if t.Payload == nil {
+		return 0
+	}
+	switch t.Payload.LeaseContractLength {
+	case "1yr":
+		return 1
+	case "3yr":
+		return 3
+	}
+	return 0
+}
+
+// GetEffectiveHourlyUSD returns the hourly price with the commitment amortized over the lease contract length.
+func (t *Term) GetEffectiveHourlyUSD() float64 {
+	leaseYear := t.GetLeaseYear()
+	if leaseYear == 0 {
+		return t.HourlyUSD
+	}
+	return t.HourlyUSD + t.CommitmentUSD/float64(leaseYear*YearInHour)
 }
 
 // Region is region-price info of a given instance
 type Region struct {
-	Code     string  `json:"code"`
+	// Code is the region code used by the provider, e.g. us-east-1
+	Code string `json:"code"`
+
+	// canonical fields, resolved from the region catalog.
+	// These fields are left empty if the region is not included in the catalog.
+	// e.g. europe-frankfurt
+	Slug string `json:"slug"`
+	// e.g. Europe (Frankfurt)
+	Name      string           `json:"name"`
+	Geography string           `json:"geography"`
+	Continent region.Continent `json:"continent"`
+	Latitude  float64          `json:"latitude"`
+	Longitude float64          `json:"longitude"`
+
 	TermList []*Term `json:"termList"`
 }
 
+// newRegion returns a region with the canonical metadata attached.
+// Some providers may use the location string in place of the region code (e.g. AWS),
+// the code is normalized to the region code of the provider if the location is known.
+func newRegion(code string, cloudProvider CloudProvider) *Region {
+	r := &Region{
+		Code: code,
+	}
+	canonical, ok := region.Lookup(cloudProvider.String(), code)
+	if !ok {
+		return r
+	}
+	if providerCode := canonical.Code(cloudProvider.String()); providerCode != "" {
+		r.Code = providerCode
+	}
+	r.Slug = canonical.Slug
+	r.Name = canonical.Name
+	r.Geography = canonical.Geography
+	r.Continent = canonical.Continent
+	r.Latitude = canonical.Latitude
+	r.Longitude = canonical.Longitude
+	return r
+}
+
 // DBInstance is the type of DBInstance
 type DBInstance struct {
 	// system fields
-	ID        int       `json:"id"`
-	RowStatus RowStatus `json:"rowStatus"`
-	CreatorID int       `json:"creatorId"`
-	UpdaterID int       `json:"updaterId"`
+	// ID is derived from the ExternalID, so it is stable across runs.
+	ID int `json:"id"`
+	// ExternalID is the identifier of the instance derived from its provider and name, e.g. AWS:db.r6g.xlarge
+	ExternalID string    `json:"externalId"`
+	RowStatus  RowStatus `json:"rowStatus"`
+	CreatorID  int       `json:"creatorId"`
+	UpdaterID  int       `json:"updaterId"`
+	UpdatedTs  int64     `json:"updatedTs"`
+	// ArchivedTs is the time the instance is no longer provided upstream, 0 if the instance is not archived.
+	ArchivedTs int64 `json:"archivedTs"`
 
 	// Region-Price info
 	RegionList []*Region `json:"regionList"`
@@ -52,112 +144,139 @@ type DBInstance struct {
 	CPU           int    `json:"cpu"`
 	Memory        string `json:"memory"`
 	Processor     string `json:"processor"`
-}
 
-// Convert convert the offer provided by client to DBInstance
-func Convert(offerList []*client.Offer, cloudProvider CloudProvider) ([]*DBInstance, error) {
-	termMap := make(map[int][]*Term)
-	for _, offer := range offerList {
-		// filter the offer does not have a instancePayload (only got price but no goods).
-		if offer.InstancePayload == nil {
-			continue
-		}
-		var termPayload *TermPayload
-		// Only reserved type has payload field
-		if offer.ChargeType == client.ChargeTypeReserved {
-			termPayload = &TermPayload{
-				LeaseContractLength: offer.ChargePayload.LeaseContractLength,
-				PurchaseOption:      offer.ChargePayload.PurchaseOption,
-			}
-		}
+	// taxonomy fields, shared by all providers.
+	Family taxonomy.Family `json:"family"`
+	// e.g. r6g, N1Standard
+	Series string `json:"series"`
+	// e.g. xlarge, 96-360
+	Size         string                `json:"size"`
+	SizeRank     int                   `json:"sizeRank"`
+	Architecture taxonomy.Architecture `json:"architecture"`
 
-		term := &Term{
-			Code:           offer.TermCode,
-			DatabaseEngine: offer.InstancePayload.DatabaseEngine,
-			Type:           offer.ChargeType,
-			Payload:        termPayload,
-			HourlyUSD:      offer.HourlyUSD,
-			CommitmentUSD:  offer.CommitmentUSD,
-		}
-		termMap[offer.ID] = append(termMap[offer.ID], term)
+	// EquivalentList is the nearest equivalents of the instance on the other providers, computed by MatchEquivalent.
+	EquivalentList []*Equivalent `json:"equivalentList"`
+}
+
+// GetMemoryGiB returns the memory of the instance in GiB, 0 if the memory is not a number.
+func (d *DBInstance) GetMemoryGiB() float64 {
+	memory, err := strconv.ParseFloat(strings.TrimSpace(d.Memory), 64)
+	if err != nil {
+		return 0
 	}
+	return memory
+}
+
+// dbInstanceBucket aggregates the terms of an instance by region during Convert.
+type dbInstanceBucket struct {
+	dbInstance *DBInstance
+	regionMap  map[string]*regionBucket
+}
+
+// regionBucket aggregates the terms of an instance in a region, keyed by the term code.
+type regionBucket struct {
+	region  *Region
+	termMap map[string]*Term
+}
 
-	incrID := 0
-	// dbInstanceMap is used to aggregate the instance by their type (e.g. db.m3.large).
-	dbInstanceMap := make(map[string]*DBInstance)
+// Convert convert the offer provided by client to DBInstance.
+// Offers are aggregated into (instance, region) buckets in a single pass, so the conversion is linear in the offers.
+// Each region owns its own copy of the terms, and the terms with the same code in a region are deduplicated.
+// The result is sorted by Sort, so it does not depend on the order of the offers except for the instance specification,
+// which is taken from the first offer of the instance.
+func Convert(offerList []*client.Offer, cloudProvider CloudProvider) ([]*DBInstance, error) {
+	// bucketMap is used to aggregate the instance by their type (e.g. db.m3.large).
+	bucketMap := make(map[string]*dbInstanceBucket)
+	// regionMap caches the normalized regions keyed by the region code in the offer, as the lookup is not free.
+	regionMap := make(map[string]*Region)
 	var dbInstanceList []*DBInstance
-	// extract dbInstance from the payload field stored in the offer.
 	for _, offer := range offerList {
 		// filter the offer does not have a instancePayload (only got price but no goods).
 		if offer.InstancePayload == nil {
 			continue
 		}
 
-		instance := offer.InstancePayload
-		cpuInt, err := strconv.Atoi(instance.CPU)
-		if err != nil {
-			return nil, fmt.Errorf("Fail to parse the CPU value from string to int, [val]: %v", instance.CPU)
-		}
-		memoryDigit := instance.Memory
-
 		// we use the instance type (e.g. db.m3.xlarge) differentiate the specification of each instances,
 		// and consider they as the same instance.
-		if _, ok := dbInstanceMap[instance.Type]; !ok {
-			dbInstance := &DBInstance{
-				ID:            incrID,
-				RowStatus:     RowStatusNormal,
-				CreatorID:     SYSTEM_BOT,
-				UpdaterID:     SYSTEM_BOT,
-				CloudProvider: cloudProvider.String(),
-				Name:          instance.Type, // e.g. db.t4g.xlarge
-				CPU:           cpuInt,
-				Memory:        memoryDigit,
-				Processor:     instance.PhysicalProcessor,
+		instance := offer.InstancePayload
+		bucket, ok := bucketMap[instance.Type]
+		if !ok {
+			cpuInt, err := strconv.Atoi(instance.CPU)
+			if err != nil {
+				return nil, fmt.Errorf("Fail to parse the CPU value from string to int, [val]: %v", instance.CPU)
+			}
+			class := taxonomy.Classify(cloudProvider.String(), instance.Type, cpuInt)
+			externalID := getExternalID(cloudProvider, instance.Type)
+			bucket = &dbInstanceBucket{
+				dbInstance: &DBInstance{
+					ID:            GetID(externalID),
+					ExternalID:    externalID,
+					RowStatus:     RowStatusNormal,
+					CreatorID:     SYSTEM_BOT,
+					UpdaterID:     SYSTEM_BOT,
+					CloudProvider: cloudProvider.String(),
+					Name:          instance.Type, // e.g. db.t4g.xlarge
+					CPU:           cpuInt,
+					Memory:        instance.Memory,
+					Processor:     instance.PhysicalProcessor,
+					Family:        class.Family,
+					Series:        class.Series,
+					Size:          class.Size,
+					SizeRank:      class.SizeRank,
+					Architecture:  class.Architecture,
+				},
+				regionMap: make(map[string]*regionBucket),
 			}
-			dbInstanceList = append(dbInstanceList, dbInstance)
-			dbInstanceMap[instance.Type] = dbInstance
-			incrID++
+			bucketMap[instance.Type] = bucket
+			dbInstanceList = append(dbInstanceList, bucket.dbInstance)
 		}
 
 		// fill in the term info of the instance
-		dbInstance := dbInstanceMap[instance.Type]
 		for _, regionCode := range offer.RegionList {
-			isRegionExist := false
-			for _, region := range dbInstance.RegionList {
-				if region.Code == regionCode {
-					isRegionExist = true
-					if _, ok := termMap[offer.ID]; ok {
-						region.TermList = append(region.TermList, termMap[offer.ID]...)
-					}
-				}
+			normalized, ok := regionMap[regionCode]
+			if !ok {
+				normalized = newRegion(regionCode, cloudProvider)
+				regionMap[regionCode] = normalized
+			}
+			// region codes in different forms may be normalized to the same one, e.g. eu-central-1 and Europe (Frankfurt).
+			rb, ok := bucket.regionMap[normalized.Code]
+			if !ok {
+				r := *normalized
+				rb = &regionBucket{region: &r, termMap: make(map[string]*Term)}
+				bucket.regionMap[normalized.Code] = rb
+				bucket.dbInstance.RegionList = append(bucket.dbInstance.RegionList, rb.region)
 			}
-			if !isRegionExist {
-				dbInstance.RegionList = append(dbInstance.RegionList, &Region{
-					Code:     regionCode,
-					TermList: termMap[offer.ID],
-				})
+			if _, ok := rb.termMap[offer.TermCode]; ok {
+				continue
 			}
+			term := newTerm(offer)
+			rb.termMap[offer.TermCode] = term
+			rb.region.TermList = append(rb.region.TermList, term)
 		}
-
 	}
 
+	FillUnitPrice(dbInstanceList)
+	Sort(dbInstanceList)
 	return dbInstanceList, nil
 }
 
-// Save save DBInstanceList to local .json file
-func Save(dbInstanceList []*DBInstance, filePath string) error {
-	fd, err := os.Create(filePath)
-	if err != nil {
-		return err
-	}
-
-	dataByted, err := json.Marshal(dbInstanceList)
-	if err != nil {
-		return err
+// newTerm returns the term of the offer.
+func newTerm(offer *client.Offer) *Term {
+	var termPayload *TermPayload
+	// Only reserved type has payload field
+	if offer.ChargeType == client.ChargeTypeReserved && offer.ChargePayload != nil {
+		termPayload = &TermPayload{
+			LeaseContractLength: offer.ChargePayload.LeaseContractLength,
+			PurchaseOption:      offer.ChargePayload.PurchaseOption,
+		}
 	}
-	if _, err := fd.Write(dataByted); err != nil {
-		return err
+	return &Term{
+		Code:           offer.TermCode,
+		RowStatus:      RowStatusNormal,
+		DatabaseEngine: offer.InstancePayload.DatabaseEngine,
+		Type:           offer.ChargeType,
+		Payload:        termPayload,
+		HourlyUSD:      offer.HourlyUSD,
+		CommitmentUSD:  offer.CommitmentUSD,
 	}
-
-	return fd.Close()
 }
@@ -3,11 +3,17 @@ package store
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"sort"
 	"testing"
 
+	"github.com/bytebase/dbcost/client"
 	"github.com/bytebase/dbcost/client/aws"
 	"github.com/bytebase/dbcost/client/gcp"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/taxonomy"
 	"github.com/stretchr/testify/require"
 )
 
@@ -56,3 +62,268 @@ func Test_GCPSaveToLocal(t *testing.T) {
 	_, err = fd.Write(dataByted)
 	require.NoError(t, err)
 }
+
+func Test_ConvertRegion(t *testing.T) {
+	offerList := []*client.Offer{
+		{
+			ID:         0,
+			SKU:        "AAA",
+			TermCode:   "AAA.a",
+			OfferType:  client.OfferTypeInstance,
+			ChargeType: client.ChargeTypeOnDemand,
+			// AWS may use the location string when the region code is empty.
+			RegionList: []string{"Europe (Frankfurt)"},
+			HourlyUSD:  1,
+			InstancePayload: &client.OfferInstancePayload{
+				Type:           "db.m5.large",
+				CPU:            "2",
+				Memory:         "8",
+				DatabaseEngine: client.EngineTypeMySQL,
+			},
+		},
+		{
+			ID:         1,
+			SKU:        "BBB",
+			TermCode:   "BBB.a",
+			OfferType:  client.OfferTypeInstance,
+			ChargeType: client.ChargeTypeOnDemand,
+			RegionList: []string{"eu-central-1", "mars-north-1"},
+			HourlyUSD:  1,
+			InstancePayload: &client.OfferInstancePayload{
+				Type:           "db.m5.large",
+				CPU:            "2",
+				Memory:         "8",
+				DatabaseEngine: client.EngineTypePostgreSQL,
+			},
+		},
+	}
+
+	dbInstanceList, err := Convert(offerList, CloudProviderAWS)
+	require.NoError(t, err)
+	require.Len(t, dbInstanceList, 1)
+	require.Equal(t, taxonomy.FamilyGeneralPurpose, dbInstanceList[0].Family)
+	require.Equal(t, 16, dbInstanceList[0].SizeRank)
+
+	regionList := dbInstanceList[0].RegionList
+	require.Len(t, regionList, 2)
+	require.Equal(t, "eu-central-1", regionList[0].Code)
+	require.Equal(t, "europe-frankfurt", regionList[0].Slug)
+	require.Equal(t, region.ContinentEurope, regionList[0].Continent)
+	require.Len(t, regionList[0].TermList, 2)
+	// unknown region is kept as is.
+	require.Equal(t, "mars-north-1", regionList[1].Code)
+	require.Empty(t, regionList[1].Slug)
+}
+
+func Test_ConvertStableID(t *testing.T) {
+	newOffer := func(id int, termCode, instanceType string) *client.Offer {
+		return &client.Offer{
+			ID:         id,
+			SKU:        termCode,
+			TermCode:   termCode,
+			OfferType:  client.OfferTypeInstance,
+			ChargeType: client.ChargeTypeOnDemand,
+			RegionList: []string{"us-east-1"},
+			InstancePayload: &client.OfferInstancePayload{
+				Type:           instanceType,
+				CPU:            "2",
+				Memory:         "8",
+				DatabaseEngine: client.EngineTypeMySQL,
+			},
+		}
+	}
+
+	dbInstanceList, err := Convert([]*client.Offer{newOffer(0, "BBB", "db.m5.large")}, CloudProviderAWS)
+	require.NoError(t, err)
+	require.Len(t, dbInstanceList, 1)
+	require.Equal(t, "AWS:db.m5.large", dbInstanceList[0].ExternalID)
+	id := dbInstanceList[0].ID
+
+	// a new instance type appearing upstream should not shift the existing ID.
+	dbInstanceList, err = Convert([]*client.Offer{newOffer(0, "AAA", "db.m5.2xlarge"), newOffer(1, "BBB", "db.m5.large")}, CloudProviderAWS)
+	require.NoError(t, err)
+	require.Len(t, dbInstanceList, 2)
+	require.Equal(t, "AWS:db.m5.2xlarge", dbInstanceList[0].ExternalID)
+	require.Equal(t, "AWS:db.m5.large", dbInstanceList[1].ExternalID)
+	require.Equal(t, id, dbInstanceList[1].ID)
+	require.NotEqual(t, id, dbInstanceList[0].ID)
+}
+
+func Test_ConvertNoAliasing(t *testing.T) {
+	offerList := []*client.Offer{
+		{
+			ID:         0,
+			TermCode:   "AAA.a",
+			ChargeType: client.ChargeTypeOnDemand,
+			// the same region in different forms, and the term should be kept once.
+			RegionList: []string{"us-east-1", "eu-central-1", "Europe (Frankfurt)"},
+			HourlyUSD:  1,
+			InstancePayload: &client.OfferInstancePayload{
+				Type:           "db.m5.large",
+				CPU:            "2",
+				Memory:         "8",
+				DatabaseEngine: client.EngineTypeMySQL,
+			},
+		},
+		{
+			ID:         1,
+			TermCode:   "BBB.a",
+			ChargeType: client.ChargeTypeOnDemand,
+			RegionList: []string{"eu-central-1"},
+			HourlyUSD:  2,
+			InstancePayload: &client.OfferInstancePayload{
+				Type:           "db.m5.large",
+				CPU:            "2",
+				Memory:         "8",
+				DatabaseEngine: client.EngineTypeMySQL,
+			},
+		},
+	}
+
+	dbInstanceList, err := Convert(offerList, CloudProviderAWS)
+	require.NoError(t, err)
+	require.Len(t, dbInstanceList, 1)
+	regionList := dbInstanceList[0].RegionList
+	require.Len(t, regionList, 2)
+	require.Equal(t, "eu-central-1", regionList[0].Code)
+	require.Len(t, regionList[0].TermList, 2)
+	require.Equal(t, "us-east-1", regionList[1].Code)
+	require.Len(t, regionList[1].TermList, 1)
+
+	// patching the term in a region should not leak to the other regions.
+	regionList[1].TermList[0].HourlyUSD = 10
+	require.Equal(t, 1.0, regionList[0].TermList[0].HourlyUSD)
+	regionList[1].TermList = append(regionList[1].TermList, &Term{Code: "CCC.a"})
+	require.Len(t, regionList[0].TermList, 2)
+
+	// the result does not depend on the order of the offers.
+	reversedList, err := Convert([]*client.Offer{offerList[1], offerList[0]}, CloudProviderAWS)
+	require.NoError(t, err)
+	require.Equal(t, "AAA.a", reversedList[0].RegionList[0].TermList[0].Code)
+	require.Equal(t, "BBB.a", reversedList[0].RegionList[0].TermList[1].Code)
+}
+
+// newBenchmarkOfferList generates the offers of the size of the AWS RDS catalog,
+// that is hundreds of instance types, each provided in tens of regions with multiple engines and terms.
+func newBenchmarkOfferList(instanceCount, regionCount int) []*client.Offer {
+	var regionCodeList []string
+	for _, r := range region.List() {
+		if code := r.Code(CloudProviderAWS); code != "" {
+			regionCodeList = append(regionCodeList, code)
+		}
+	}
+	engineList := []client.EngineType{client.EngineTypeMySQL, client.EngineTypePostgreSQL}
+	payloadList := []*client.ChargePayload{
+		nil,
+		{LeaseContractLength: "1yr", PurchaseOption: "No Upfront"},
+		{LeaseContractLength: "1yr", PurchaseOption: "Partial Upfront"},
+		{LeaseContractLength: "1yr", PurchaseOption: "All Upfront"},
+		{LeaseContractLength: "3yr", PurchaseOption: "Partial Upfront"},
+		{LeaseContractLength: "3yr", PurchaseOption: "All Upfront"},
+	}
+
+	var offerList []*client.Offer
+	for i := 0; i < instanceCount; i++ {
+		instanceType := fmt.Sprintf("db.m%d.%dxlarge", i%10, i/10+1)
+		for j := 0; j < regionCount; j++ {
+			for _, engine := range engineList {
+				sku := fmt.Sprintf("SKU%d-%d-%s", i, j, engine)
+				for k, payload := range payloadList {
+					offer := &client.Offer{
+						ID:            len(offerList),
+						SKU:           sku,
+						TermCode:      fmt.Sprintf("%s.%d", sku, k),
+						OfferType:     client.OfferTypeInstance,
+						ChargeType:    client.ChargeTypeOnDemand,
+						ChargePayload: payload,
+						RegionList:    []string{regionCodeList[j%len(regionCodeList)]},
+						HourlyUSD:     float64(i + 1),
+						InstancePayload: &client.OfferInstancePayload{
+							Type:           instanceType,
+							CPU:            "4",
+							Memory:         "16",
+							DatabaseEngine: engine,
+						},
+					}
+					if payload != nil {
+						offer.ChargeType = client.ChargeTypeReserved
+						offer.CommitmentUSD = float64(i + 1)
+					}
+					offerList = append(offerList, offer)
+				}
+			}
+		}
+	}
+	return offerList
+}
+
+func Benchmark_Convert(b *testing.B) {
+	for _, size := range []struct {
+		instanceCount int
+		regionCount   int
+	}{
+		{instanceCount: 30, regionCount: 5},
+		{instanceCount: 300, regionCount: 25},
+		{instanceCount: 300, regionCount: 50},
+	} {
+		offerList := newBenchmarkOfferList(size.instanceCount, size.regionCount)
+		b.Run(fmt.Sprintf("offer=%d", len(offerList)), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := Convert(offerList, CloudProviderAWS); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// awsOfferFixturePath is the full AWS RDS offer file, which is hundreds of MB so it is downloaded on the first run rather than checked in.
+const awsOfferFixturePath = "testdata/aws_rds_index.json"
+
+// getAWSOfferFixture returns the offers of the full AWS RDS catalog, downloading the offer file if it is not cached yet.
+func getAWSOfferFixture(b *testing.B) []*client.Offer {
+	if _, err := os.Stat(awsOfferFixturePath); os.IsNotExist(err) {
+		res, err := http.Get(aws.InfoEndPoint)
+		if err != nil {
+			b.Skipf("Fail to download the AWS offer file, err: %s", err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			b.Skipf("Fail to download the AWS offer file, status: %s", res.Status)
+		}
+		fd, err := os.Create(awsOfferFixturePath)
+		require.NoError(b, err)
+		if _, err := io.Copy(fd, res.Body); err != nil {
+			fd.Close()
+			os.Remove(awsOfferFixturePath)
+			b.Fatal(err)
+		}
+		require.NoError(b, fd.Close())
+	}
+	offerList, err := aws.MockGetOffer(awsOfferFixturePath)
+	require.NoError(b, err)
+	// the offers are extracted from maps, sort them so that the prefixes benchmarked are stable across runs.
+	sort.Slice(offerList, func(i, j int) bool { return offerList[i].TermCode < offerList[j].TermCode })
+	return offerList
+}
+
+// Benchmark_ConvertAWSCatalog converts the full AWS catalog and its prefixes, so the scaling in the offers is measured on the real data.
+// It is skipped with -short, e.g. go test ./store -run ^$ -bench ConvertAWSCatalog -benchmem
+func Benchmark_ConvertAWSCatalog(b *testing.B) {
+	if testing.Short() {
+		b.Skip("the full AWS offer file is downloaded on the first run")
+	}
+	offerList := getAWSOfferFixture(b)
+	for _, divisor := range []int{4, 2, 1} {
+		prefixList := offerList[:len(offerList)/divisor]
+		b.Run(fmt.Sprintf("offer=%d", len(prefixList)), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := Convert(prefixList, CloudProviderAWS); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
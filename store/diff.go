@@ -0,0 +1,293 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// InstanceChange is an instance added or removed between two snapshots.
+type InstanceChange struct {
+	ExternalID    string `json:"externalId"`
+	CloudProvider string `json:"cloudProvider"`
+	Name          string `json:"name"`
+}
+
+// RegionChange is a region of an instance added or removed between two snapshots.
+type RegionChange struct {
+	ExternalID string `json:"externalId"`
+	RegionCode string `json:"regionCode"`
+}
+
+// TermChange is a term added or removed between two snapshots.
+type TermChange struct {
+	ExternalID string `json:"externalId"`
+	RegionCode string `json:"regionCode"`
+	Term       *Term  `json:"term"`
+}
+
+// PriceChange is the price change of a term between two snapshots.
+type PriceChange struct {
+	ExternalID string `json:"externalId"`
+	RegionCode string `json:"regionCode"`
+	TermCode   string `json:"termCode"`
+	Term       *Term  `json:"term"`
+
+	OldHourlyUSD float64 `json:"oldHourlyUSD"`
+	NewHourlyUSD float64 `json:"newHourlyUSD"`
+	// HourlyChangePercent is 0 if the old price is 0.
+	HourlyChangePercent float64 `json:"hourlyChangePercent"`
+
+	OldCommitmentUSD float64 `json:"oldCommitmentUSD"`
+	NewCommitmentUSD float64 `json:"newCommitmentUSD"`
+	// CommitmentChangePercent is 0 if the old price is 0.
+	CommitmentChangePercent float64 `json:"commitmentChangePercent"`
+}
+
+// Diff is the difference between two snapshots of the dbInstance list.
+type Diff struct {
+	AddedInstanceList   []*InstanceChange `json:"addedInstanceList"`
+	RemovedInstanceList []*InstanceChange `json:"removedInstanceList"`
+	AddedRegionList     []*RegionChange   `json:"addedRegionList"`
+	RemovedRegionList   []*RegionChange   `json:"removedRegionList"`
+	AddedTermList       []*TermChange     `json:"addedTermList"`
+	RemovedTermList     []*TermChange     `json:"removedTermList"`
+	PriceChangeList     []*PriceChange    `json:"priceChangeList"`
+}
+
+// IsEmpty returns true if there is no difference between the two snapshots.
+func (d *Diff) IsEmpty() bool {
+	return len(d.AddedInstanceList) == 0 && len(d.RemovedInstanceList) == 0 &&
+		len(d.AddedRegionList) == 0 && len(d.RemovedRegionList) == 0 &&
+		len(d.AddedTermList) == 0 && len(d.RemovedTermList) == 0 &&
+		len(d.PriceChangeList) == 0
+}
+
+// Compare compares the old and the new snapshot of the dbInstance list.
+// Instances are matched by their external ID, regions by their code and terms by their code.
+// Archived instances and terms are considered as removed.
+func Compare(oldList, newList []*DBInstance) *Diff {
+	diff := &Diff{}
+	oldMap := getActiveDBInstanceMap(oldList)
+	newMap := getActiveDBInstanceMap(newList)
+
+	for _, externalID := range getSortedKey(oldMap) {
+		if _, ok := newMap[externalID]; !ok {
+			diff.RemovedInstanceList = append(diff.RemovedInstanceList, newInstanceChange(externalID, oldMap[externalID]))
+		}
+	}
+	for _, externalID := range getSortedKey(newMap) {
+		newInstance := newMap[externalID]
+		oldInstance, ok := oldMap[externalID]
+		if !ok {
+			diff.AddedInstanceList = append(diff.AddedInstanceList, newInstanceChange(externalID, newInstance))
+			continue
+		}
+		compareRegion(diff, externalID, oldInstance.RegionList, newInstance.RegionList)
+	}
+	return diff
+}
+
+func compareRegion(diff *Diff, externalID string, oldList, newList []*Region) {
+	oldMap := getActiveRegionMap(oldList)
+	newMap := getActiveRegionMap(newList)
+
+	for _, code := range getSortedKey(oldMap) {
+		if _, ok := newMap[code]; !ok {
+			diff.RemovedRegionList = append(diff.RemovedRegionList, &RegionChange{ExternalID: externalID, RegionCode: code})
+		}
+	}
+	for _, code := range getSortedKey(newMap) {
+		oldRegion, ok := oldMap[code]
+		if !ok {
+			diff.AddedRegionList = append(diff.AddedRegionList, &RegionChange{ExternalID: externalID, RegionCode: code})
+			continue
+		}
+		compareTerm(diff, externalID, code, oldRegion.TermList, newMap[code].TermList)
+	}
+}
+
+func compareTerm(diff *Diff, externalID, regionCode string, oldList, newList []*Term) {
+	oldMap := getActiveTermMap(oldList)
+	newMap := getActiveTermMap(newList)
+
+	for _, code := range getSortedKey(oldMap) {
+		if _, ok := newMap[code]; !ok {
+			diff.RemovedTermList = append(diff.RemovedTermList, &TermChange{ExternalID: externalID, RegionCode: regionCode, Term: oldMap[code]})
+		}
+	}
+	for _, code := range getSortedKey(newMap) {
+		newTerm := newMap[code]
+		oldTerm, ok := oldMap[code]
+		if !ok {
+			diff.AddedTermList = append(diff.AddedTermList, &TermChange{ExternalID: externalID, RegionCode: regionCode, Term: newTerm})
+			continue
+		}
+		if oldTerm.HourlyUSD == newTerm.HourlyUSD && oldTerm.CommitmentUSD == newTerm.CommitmentUSD {
+			continue
+		}
+		diff.PriceChangeList = append(diff.PriceChangeList, &PriceChange{
+			ExternalID:              externalID,
+			RegionCode:              regionCode,
+			TermCode:                code,
+			Term:                    newTerm,
+			OldHourlyUSD:            oldTerm.HourlyUSD,
+			NewHourlyUSD:            newTerm.HourlyUSD,
+			HourlyChangePercent:     getChangePercent(oldTerm.HourlyUSD, newTerm.HourlyUSD),
+			OldCommitmentUSD:        oldTerm.CommitmentUSD,
+			NewCommitmentUSD:        newTerm.CommitmentUSD,
+			CommitmentChangePercent: getChangePercent(oldTerm.CommitmentUSD, newTerm.CommitmentUSD),
+		})
+	}
+}
+
+// getDBInstanceMap returns the instances keyed by the external ID.
+func getDBInstanceMap(dbInstanceList []*DBInstance) map[string]*DBInstance {
+	dbInstanceMap := make(map[string]*DBInstance)
+	for _, dbInstance := range dbInstanceList {
+		dbInstanceMap[dbInstance.GetExternalID()] = dbInstance
+	}
+	return dbInstanceMap
+}
+
+// getActiveDBInstanceMap returns the instances that are not archived keyed by the external ID.
+func getActiveDBInstanceMap(dbInstanceList []*DBInstance) map[string]*DBInstance {
+	dbInstanceMap := make(map[string]*DBInstance)
+	for _, dbInstance := range dbInstanceList {
+		if !dbInstance.IsArchived() {
+			dbInstanceMap[dbInstance.GetExternalID()] = dbInstance
+		}
+	}
+	return dbInstanceMap
+}
+
+// getActiveRegionMap returns the regions with at least one term not archived keyed by the code.
+func getActiveRegionMap(regionList []*Region) map[string]*Region {
+	regionMap := make(map[string]*Region)
+	for _, region := range regionList {
+		if len(getActiveTermMap(region.TermList)) > 0 {
+			regionMap[region.Code] = region
+		}
+	}
+	return regionMap
+}
+
+// getActiveTermMap returns the terms that are not archived keyed by the code.
+func getActiveTermMap(termList []*Term) map[string]*Term {
+	termMap := make(map[string]*Term)
+	for _, term := range termList {
+		if !term.IsArchived() {
+			termMap[term.Code] = term
+		}
+	}
+	return termMap
+}
+
+func newInstanceChange(externalID string, dbInstance *DBInstance) *InstanceChange {
+	return &InstanceChange{
+		ExternalID:    externalID,
+		CloudProvider: dbInstance.CloudProvider,
+		Name:          dbInstance.Name,
+	}
+}
+
+func getSortedKey[T any](m map[string]T) []string {
+	var keyList []string
+	for key := range m {
+		keyList = append(keyList, key)
+	}
+	sort.Strings(keyList)
+	return keyList
+}
+
+func getChangePercent(oldVal, newVal float64) float64 {
+	if oldVal == 0 {
+		return 0
+	}
+	return (newVal - oldVal) / oldVal * 100
+}
+
+// markdownMaxRow is the max row rendered in each section of the markdown,
+// as the body of a GitHub PR is limited to 65536 characters.
+const markdownMaxRow = 100
+
+// Markdown renders the diff as markdown, which is intended to be used as the body of the data PR.
+func (d *Diff) Markdown() string {
+	var b strings.Builder
+	b.WriteString("## Pricing data changes\n\n")
+	if d.IsEmpty() {
+		b.WriteString("No changes.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Change | Count |\n| --- | --- |\n")
+	fmt.Fprintf(&b, "| Added instances | %d |\n", len(d.AddedInstanceList))
+	fmt.Fprintf(&b, "| Removed instances | %d |\n", len(d.RemovedInstanceList))
+	fmt.Fprintf(&b, "| Added regions | %d |\n", len(d.AddedRegionList))
+	fmt.Fprintf(&b, "| Removed regions | %d |\n", len(d.RemovedRegionList))
+	fmt.Fprintf(&b, "| Added terms | %d |\n", len(d.AddedTermList))
+	fmt.Fprintf(&b, "| Removed terms | %d |\n", len(d.RemovedTermList))
+	fmt.Fprintf(&b, "| Price changes | %d |\n", len(d.PriceChangeList))
+
+	writeInstanceSection(&b, "Added instances", d.AddedInstanceList)
+	writeInstanceSection(&b, "Removed instances", d.RemovedInstanceList)
+	writeRegionSection(&b, "Added regions", d.AddedRegionList)
+	writeRegionSection(&b, "Removed regions", d.RemovedRegionList)
+
+	if len(d.PriceChangeList) > 0 {
+		b.WriteString("\n### Price changes\n\n")
+		b.WriteString("| Instance | Region | Term | Engine | Charge | Hourly (USD) | Change | Commitment (USD) | Change |\n")
+		b.WriteString("| --- | --- | --- | --- | --- | --- | --- | --- | --- |\n")
+		for i, change := range d.PriceChangeList {
+			if i == markdownMaxRow {
+				writeTruncated(&b, len(d.PriceChangeList)-markdownMaxRow)
+				break
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %v → %v | %s | %v → %v | %s |\n",
+				change.ExternalID, change.RegionCode, change.TermCode, change.Term.DatabaseEngine, change.Term.GetName(),
+				change.OldHourlyUSD, change.NewHourlyUSD, formatPercent(change.HourlyChangePercent),
+				change.OldCommitmentUSD, change.NewCommitmentUSD, formatPercent(change.CommitmentChangePercent),
+			)
+		}
+	}
+	return b.String()
+}
+
+func writeInstanceSection(b *strings.Builder, title string, changeList []*InstanceChange) {
+	if len(changeList) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", title)
+	for i, change := range changeList {
+		if i == markdownMaxRow {
+			writeTruncated(b, len(changeList)-markdownMaxRow)
+			break
+		}
+		fmt.Fprintf(b, "- `%s`\n", change.ExternalID)
+	}
+}
+
+func writeRegionSection(b *strings.Builder, title string, changeList []*RegionChange) {
+	if len(changeList) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", title)
+	for i, change := range changeList {
+		if i == markdownMaxRow {
+			writeTruncated(b, len(changeList)-markdownMaxRow)
+			break
+		}
+		fmt.Fprintf(b, "- `%s` in `%s`\n", change.ExternalID, change.RegionCode)
+	}
+}
+
+func writeTruncated(b *strings.Builder, count int) {
+	fmt.Fprintf(b, "\n_... and %d more._\n", count)
+}
+
+func formatPercent(percent float64) string {
+	if percent == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%+.2f%%", percent)
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Compare(t *testing.T) {
+	newInstance := func(name string, regionList ...*Region) *DBInstance {
+		return &DBInstance{
+			ExternalID:    getExternalID(CloudProviderAWS, name),
+			CloudProvider: CloudProviderAWS,
+			Name:          name,
+			RegionList:    regionList,
+		}
+	}
+	newTerm := func(code string, hourlyUSD float64) *Term {
+		return &Term{Code: code, DatabaseEngine: client.EngineTypeMySQL, Type: client.ChargeTypeOnDemand, HourlyUSD: hourlyUSD}
+	}
+
+	oldList := []*DBInstance{
+		newInstance("db.m3.large", &Region{Code: "us-east-1", TermList: []*Term{newTerm("A.a", 1)}}),
+		newInstance("db.m5.large",
+			&Region{Code: "us-east-1", TermList: []*Term{newTerm("B.a", 1), newTerm("B.b", 2)}},
+			&Region{Code: "eu-west-1", TermList: []*Term{newTerm("C.a", 1)}},
+		),
+	}
+	newList := []*DBInstance{
+		newInstance("db.m5.large",
+			&Region{Code: "us-east-1", TermList: []*Term{newTerm("B.a", 1.1), newTerm("B.c", 3)}},
+			&Region{Code: "eu-central-1", TermList: []*Term{newTerm("D.a", 1)}},
+		),
+		newInstance("db.m6g.large", &Region{Code: "us-east-1", TermList: []*Term{newTerm("E.a", 1)}}),
+	}
+
+	diff := Compare(oldList, newList)
+	require.False(t, diff.IsEmpty())
+	require.Equal(t, []*InstanceChange{{"AWS:db.m6g.large", CloudProviderAWS, "db.m6g.large"}}, diff.AddedInstanceList)
+	require.Equal(t, []*InstanceChange{{"AWS:db.m3.large", CloudProviderAWS, "db.m3.large"}}, diff.RemovedInstanceList)
+	require.Equal(t, []*RegionChange{{"AWS:db.m5.large", "eu-central-1"}}, diff.AddedRegionList)
+	require.Equal(t, []*RegionChange{{"AWS:db.m5.large", "eu-west-1"}}, diff.RemovedRegionList)
+	require.Len(t, diff.AddedTermList, 1)
+	require.Equal(t, "B.c", diff.AddedTermList[0].Term.Code)
+	require.Len(t, diff.RemovedTermList, 1)
+	require.Equal(t, "B.b", diff.RemovedTermList[0].Term.Code)
+	require.Len(t, diff.PriceChangeList, 1)
+	require.Equal(t, "B.a", diff.PriceChangeList[0].TermCode)
+	require.InDelta(t, 10, diff.PriceChangeList[0].HourlyChangePercent, 1e-9)
+
+	markdown := diff.Markdown()
+	require.Contains(t, markdown, "| Price changes | 1 |")
+	require.Contains(t, markdown, "+10.00%")
+
+	require.True(t, Compare(oldList, oldList).IsEmpty())
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"math"
+	"sort"
+)
+
+// EquivalentLimit is the max count of the equivalents kept for each other provider.
+const EquivalentLimit = 3
+
+// The weights of the dimensions in the similarity score, summing up to 1.
+const (
+	cpuWeight          = 0.35
+	memoryWeight       = 0.35
+	familyWeight       = 0.2
+	architectureWeight = 0.1
+)
+
+// Equivalent is an instance on another provider similar to the instance.
+type Equivalent struct {
+	ExternalID string `json:"externalId"`
+	// Score is the similarity from 0 to 1, where 1 is the same vCPU, memory, family and architecture.
+	Score float64 `json:"score"`
+}
+
+// GetSimilarity returns the similarity score of the instances from 0 to 1.
+// The vCPU and the memory are scored by the ratio of the smaller one to the larger one,
+// the family and the architecture are scored 1 if they are the same and 0 otherwise.
+func GetSimilarity(a, b *DBInstance) float64 {
+	score := cpuWeight*getRatio(float64(a.CPU), float64(b.CPU)) + memoryWeight*getRatio(a.GetMemoryGiB(), b.GetMemoryGiB())
+	if a.Family == b.Family {
+		score += familyWeight
+	}
+	if a.Architecture == b.Architecture {
+		score += architectureWeight
+	}
+	// round the score so that the output is stable across platforms.
+	return math.Round(score*10000) / 10000
+}
+
+// MatchEquivalent fills in the EquivalentList of each instance with the most similar instances on each other provider,
+// at most EquivalentLimit for each provider, ordered by the score descending, then by the external ID.
+// Archived instances are neither matched nor used as equivalents, and their EquivalentList is cleared.
+func MatchEquivalent(dbInstanceList []*DBInstance) {
+	providerMap := make(map[string][]*DBInstance)
+	for _, dbInstance := range dbInstanceList {
+		if !dbInstance.IsArchived() {
+			providerMap[dbInstance.CloudProvider] = append(providerMap[dbInstance.CloudProvider], dbInstance)
+		}
+	}
+
+	for _, dbInstance := range dbInstanceList {
+		dbInstance.EquivalentList = nil
+		if dbInstance.IsArchived() {
+			continue
+		}
+		for _, cloudProvider := range getSortedKey(providerMap) {
+			if cloudProvider == dbInstance.CloudProvider {
+				continue
+			}
+			var equivalentList []*Equivalent
+			for _, candidate := range providerMap[cloudProvider] {
+				equivalentList = append(equivalentList, &Equivalent{
+					ExternalID: candidate.GetExternalID(),
+					Score:      GetSimilarity(dbInstance, candidate),
+				})
+			}
+			sort.Slice(equivalentList, func(i, j int) bool {
+				if equivalentList[i].Score != equivalentList[j].Score {
+					return equivalentList[i].Score > equivalentList[j].Score
+				}
+				return equivalentList[i].ExternalID < equivalentList[j].ExternalID
+			})
+			if len(equivalentList) > EquivalentLimit {
+				equivalentList = equivalentList[:EquivalentLimit]
+			}
+			dbInstance.EquivalentList = append(dbInstance.EquivalentList, equivalentList...)
+		}
+	}
+}
+
+// getRatio returns the ratio of the smaller value to the larger one, 1 if both are 0.
+func getRatio(a, b float64) float64 {
+	if a == b {
+		return 1
+	}
+	return math.Min(a, b) / math.Max(a, b)
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/taxonomy"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchEquivalent(t *testing.T) {
+	newDBInstance := func(cloudProvider, name string, cpu int, memory string) *DBInstance {
+		class := taxonomy.Classify(cloudProvider, name, cpu)
+		return &DBInstance{
+			CloudProvider: cloudProvider,
+			Name:          name,
+			CPU:           cpu,
+			Memory:        memory,
+			RowStatus:     RowStatusNormal,
+			Family:        class.Family,
+			Architecture:  class.Architecture,
+		}
+	}
+	r6g := newDBInstance(CloudProviderAWS, "db.r6g.xlarge", 4, "32")
+	r5 := newDBInstance(CloudProviderAWS, "db.r5.xlarge", 4, "32")
+	highmem := newDBInstance(CloudProviderGCP, "db-N1Highmem-4-26", 4, "26")
+	standard := newDBInstance(CloudProviderGCP, "db-N1Standard-4-15", 4, "15")
+	large := newDBInstance(CloudProviderGCP, "db-N1Highmem-16-104", 16, "104")
+	archived := newDBInstance(CloudProviderGCP, "db-N1Highmem-4-32", 4, "32")
+	archived.RowStatus = RowStatusArchived
+	dbInstanceList := []*DBInstance{r6g, r5, highmem, standard, large, archived}
+
+	MatchEquivalent(dbInstanceList)
+
+	// the memory optimized shape with the closest memory is the nearest, the archived one is excluded.
+	require.Len(t, r5.EquivalentList, 3)
+	require.Equal(t, "GCP:db-N1Highmem-4-26", r5.EquivalentList[0].ExternalID)
+	require.Equal(t, GetSimilarity(r5, highmem), r5.EquivalentList[0].Score)
+	for i := 1; i < len(r5.EquivalentList); i++ {
+		require.GreaterOrEqual(t, r5.EquivalentList[i-1].Score, r5.EquivalentList[i].Score)
+	}
+	// the architecture lowers the score of Graviton.
+	require.Less(t, r6g.EquivalentList[0].Score, r5.EquivalentList[0].Score)
+	// only the instances on the other providers are matched.
+	require.Len(t, highmem.EquivalentList, 2)
+	require.Equal(t, "AWS:db.r5.xlarge", highmem.EquivalentList[0].ExternalID)
+	require.Nil(t, archived.EquivalentList)
+
+	require.Equal(t, 1.0, GetSimilarity(r5, r5))
+	require.InDelta(t, 0.35+0.35*15.0/32+0.1, GetSimilarity(r5, standard), 1e-4)
+}
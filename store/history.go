@@ -0,0 +1,260 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/bytebase/dbcost/client"
+)
+
+// PriceRecord is a record in the price history.
+// The history is an append-only change log, a record is only appended when the price of a term changes,
+// the term appears for the first time or the term is removed upstream.
+type PriceRecord struct {
+	// Ts is the unix timestamp (in seconds) when the change was detected.
+	Ts         int64  `json:"ts"`
+	ExternalID string `json:"externalId"`
+	RegionCode string `json:"regionCode"`
+	Term       *Term  `json:"term"`
+	// Removed is true if the term is no longer provided, the price in Term is the last price seen.
+	Removed bool `json:"removed,omitempty"`
+}
+
+// History is the price history loaded from the change log.
+type History struct {
+	recordList []*PriceRecord
+}
+
+// priceKey identifies a term of an instance in a region.
+type priceKey struct {
+	externalID string
+	regionCode string
+	termCode   string
+}
+
+// LoadHistory loads the price history from the change log, an empty history is returned if the file does not exist.
+func LoadHistory(filePath string) (*History, error) {
+	fd, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return &History{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	history := &History{}
+	scanner := bufio.NewScanner(fd)
+	// A record is a single line, the default buffer size is enough, we enlarge it anyway for safety.
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &PriceRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, fmt.Errorf("Fail to unmarshal the record at line %d, [internal]: %v", line, err)
+		}
+		history.recordList = append(history.recordList, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// AppendHistory compares the dbInstanceList with the latest prices in the change log,
+// and appends the changes detected at ts to it. The number of the appended records is returned.
+func AppendHistory(filePath string, dbInstanceList []*DBInstance, ts int64) (int, error) {
+	history, err := LoadHistory(filePath)
+	if err != nil {
+		return 0, err
+	}
+	latestMap := history.latest()
+
+	var recordList []*PriceRecord
+	currentMap := make(map[priceKey]bool)
+	for _, dbInstance := range dbInstanceList {
+		for _, region := range dbInstance.RegionList {
+			for _, term := range region.TermList {
+				// archived terms are no longer provided, they are recorded as removed below.
+				if dbInstance.IsArchived() || term.IsArchived() {
+					continue
+				}
+				key := priceKey{externalID: dbInstance.ExternalID, regionCode: region.Code, termCode: term.Code}
+				currentMap[key] = true
+				if latest, ok := latestMap[key]; ok && !latest.Removed &&
+					latest.Term.HourlyUSD == term.HourlyUSD && latest.Term.CommitmentUSD == term.CommitmentUSD {
+					continue
+				}
+				recordList = append(recordList, &PriceRecord{
+					Ts:         ts,
+					ExternalID: dbInstance.ExternalID,
+					RegionCode: region.Code,
+					Term:       term,
+				})
+			}
+		}
+	}
+	var removedList []*PriceRecord
+	for key, latest := range latestMap {
+		if currentMap[key] || latest.Removed {
+			continue
+		}
+		removedList = append(removedList, &PriceRecord{
+			Ts:         ts,
+			ExternalID: latest.ExternalID,
+			RegionCode: latest.RegionCode,
+			Term:       latest.Term,
+			Removed:    true,
+		})
+	}
+	// map iteration is random, sort the removed records to generate a stable output.
+	sort.Slice(removedList, func(i, j int) bool { return removedList[i].key().less(removedList[j].key()) })
+	recordList = append(recordList, removedList...)
+
+	if len(recordList) == 0 {
+		return 0, nil
+	}
+	fd, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+	writer := bufio.NewWriter(fd)
+	for _, record := range recordList {
+		dataByted, err := json.Marshal(record)
+		if err != nil {
+			fd.Close()
+			return 0, err
+		}
+		if _, err := writer.Write(append(dataByted, '\n')); err != nil {
+			fd.Close()
+			return 0, err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		fd.Close()
+		return 0, err
+	}
+	return len(recordList), fd.Close()
+}
+
+// latest returns the latest record of each term.
+func (h *History) latest() map[priceKey]*PriceRecord {
+	latestMap := make(map[priceKey]*PriceRecord)
+	for _, record := range h.recordList {
+		latestMap[record.key()] = record
+	}
+	return latestMap
+}
+
+func (r *PriceRecord) key() priceKey {
+	return priceKey{externalID: r.ExternalID, regionCode: r.RegionCode, termCode: r.Term.Code}
+}
+
+func (k priceKey) less(other priceKey) bool {
+	if k.externalID != other.externalID {
+		return k.externalID < other.externalID
+	}
+	if k.regionCode != other.regionCode {
+		return k.regionCode < other.regionCode
+	}
+	return k.termCode < other.termCode
+}
+
+// PriceQuery is the query of the price series, empty fields match all.
+type PriceQuery struct {
+	ExternalID          string
+	RegionCode          string
+	DatabaseEngine      client.EngineType
+	TermCode            string
+	ChargeType          client.ChargeType
+	LeaseContractLength string
+	PurchaseOption      string
+}
+
+// PricePoint is the price of a term since Ts.
+type PricePoint struct {
+	Ts            int64   `json:"ts"`
+	HourlyUSD     float64 `json:"hourlyUSD"`
+	CommitmentUSD float64 `json:"commitmentUSD"`
+	// Removed is true if the term is no longer provided since Ts.
+	Removed bool `json:"removed,omitempty"`
+}
+
+// PriceSeries is the price history of a term of an instance in a region.
+type PriceSeries struct {
+	ExternalID string `json:"externalId"`
+	RegionCode string `json:"regionCode"`
+	// Term is the latest term seen.
+	Term      *Term         `json:"term"`
+	PointList []*PricePoint `json:"pointList"`
+}
+
+// Series returns the price series of the terms matching the query, ordered by instance, region and term code.
+// Points of each series are ordered by time.
+func (h *History) Series(query *PriceQuery) []*PriceSeries {
+	seriesMap := make(map[priceKey]*PriceSeries)
+	var keyList []priceKey
+	for _, record := range h.recordList {
+		if !query.match(record) {
+			continue
+		}
+		key := record.key()
+		series, ok := seriesMap[key]
+		if !ok {
+			series = &PriceSeries{
+				ExternalID: record.ExternalID,
+				RegionCode: record.RegionCode,
+			}
+			seriesMap[key] = series
+			keyList = append(keyList, key)
+		}
+		series.Term = record.Term
+		series.PointList = append(series.PointList, &PricePoint{
+			Ts:            record.Ts,
+			HourlyUSD:     record.Term.HourlyUSD,
+			CommitmentUSD: record.Term.CommitmentUSD,
+			Removed:       record.Removed,
+		})
+	}
+
+	sort.Slice(keyList, func(i, j int) bool { return keyList[i].less(keyList[j]) })
+	var seriesList []*PriceSeries
+	for _, key := range keyList {
+		series := seriesMap[key]
+		sort.SliceStable(series.PointList, func(i, j int) bool { return series.PointList[i].Ts < series.PointList[j].Ts })
+		seriesList = append(seriesList, series)
+	}
+	return seriesList
+}
+
+func (q *PriceQuery) match(record *PriceRecord) bool {
+	term := record.Term
+	if q.ExternalID != "" && q.ExternalID != record.ExternalID {
+		return false
+	}
+	if q.RegionCode != "" && q.RegionCode != record.RegionCode {
+		return false
+	}
+	if q.DatabaseEngine != "" && q.DatabaseEngine != term.DatabaseEngine {
+		return false
+	}
+	if q.TermCode != "" && q.TermCode != term.Code {
+		return false
+	}
+	if q.ChargeType != "" && q.ChargeType != term.Type {
+		return false
+	}
+	if q.LeaseContractLength != "" && (term.Payload == nil || q.LeaseContractLength != term.Payload.LeaseContractLength) {
+		return false
+	}
+	if q.PurchaseOption != "" && (term.Payload == nil || q.PurchaseOption != term.Payload.PurchaseOption) {
+		return false
+	}
+	return true
+}
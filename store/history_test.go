@@ -0,0 +1,52 @@
+package store
+
+import (
+	"path"
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_History(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "priceHistory.jsonl")
+	newList := func(hourlyUSD float64, withReserved bool) []*DBInstance {
+		termList := []*Term{{Code: "A.a", DatabaseEngine: client.EngineTypeMySQL, Type: client.ChargeTypeOnDemand, HourlyUSD: hourlyUSD}}
+		if withReserved {
+			termList = append(termList, &Term{
+				Code:           "A.b",
+				DatabaseEngine: client.EngineTypeMySQL,
+				Type:           client.ChargeTypeReserved,
+				Payload:        &TermPayload{LeaseContractLength: "1yr", PurchaseOption: "All Upfront"},
+				CommitmentUSD:  1000,
+			})
+		}
+		return []*DBInstance{{
+			ExternalID: "AWS:db.m5.large",
+			RegionList: []*Region{{Code: "eu-west-1", TermList: termList}},
+		}}
+	}
+
+	count, err := AppendHistory(filePath, newList(1, true), 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	// nothing changed
+	count, err = AppendHistory(filePath, newList(1, true), 200)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	// price raised and the reserved term is removed.
+	count, err = AppendHistory(filePath, newList(1.2, false), 300)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	history, err := LoadHistory(filePath)
+	require.NoError(t, err)
+
+	seriesList := history.Series(&PriceQuery{ExternalID: "AWS:db.m5.large", RegionCode: "eu-west-1", ChargeType: client.ChargeTypeOnDemand})
+	require.Len(t, seriesList, 1)
+	require.Equal(t, []*PricePoint{{Ts: 100, HourlyUSD: 1}, {Ts: 300, HourlyUSD: 1.2}}, seriesList[0].PointList)
+
+	seriesList = history.Series(&PriceQuery{LeaseContractLength: "1yr"})
+	require.Len(t, seriesList, 1)
+	require.Equal(t, []*PricePoint{{Ts: 100, CommitmentUSD: 1000}, {Ts: 300, CommitmentUSD: 1000, Removed: true}}, seriesList[0].PointList)
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// maxSafeID is the max integer that can be represented exactly by a JavaScript number (2^53 - 1),
+// as the ID is consumed by the frontend.
+const maxSafeID = 1<<53 - 1
+
+// getExternalID returns the external ID of the instance, which is derived from its content and stable across runs.
+// e.g. AWS:db.r6g.xlarge
+func getExternalID(cloudProvider CloudProvider, name string) string {
+	return fmt.Sprintf("%s:%s", cloudProvider, name)
+}
+
+// GetExternalID returns the external ID of the instance.
+// Datasets generated before the external ID was introduced fall back to the provider and the name.
+func (d *DBInstance) GetExternalID() string {
+	if d.ExternalID != "" {
+		return d.ExternalID
+	}
+	return getExternalID(CloudProvider(d.CloudProvider), d.Name)
+}
+
+// GetID returns the ID derived from the external ID.
+// A new instance type appearing upstream will not shift the IDs of the existing instances.
+func GetID(externalID string) int {
+	h := fnv.New64a()
+	// hash.Hash never returns an error on Write.
+	_, _ = h.Write([]byte(externalID))
+	return int(h.Sum64() & maxSafeID)
+}
+
+// Sort sorts the dbInstanceList and its regions and terms to generate a stable output.
+// Instances are sorted by their external ID, regions by their code and terms by their code.
+func Sort(dbInstanceList []*DBInstance) {
+	sort.SliceStable(dbInstanceList, func(i, j int) bool {
+		return dbInstanceList[i].ExternalID < dbInstanceList[j].ExternalID
+	})
+	for _, dbInstance := range dbInstanceList {
+		regionList := dbInstance.RegionList
+		sort.SliceStable(regionList, func(i, j int) bool { return regionList[i].Code < regionList[j].Code })
+		for _, region := range regionList {
+			termList := region.TermList
+			sort.SliceStable(termList, func(i, j int) bool { return termList[i].Code < termList[j].Code })
+		}
+	}
+}
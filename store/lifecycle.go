@@ -0,0 +1,165 @@
+package store
+
+// IsArchived returns true if the instance is no longer provided upstream.
+func (d *DBInstance) IsArchived() bool {
+	return d.RowStatus == RowStatusArchived
+}
+
+// IsArchived returns true if the term is no longer provided upstream.
+func (t *Term) IsArchived() bool {
+	return t.RowStatus == RowStatusArchived
+}
+
+// Merge merges the dbInstanceList converted from the latest upstream data into the previous dataset at ts.
+//   - Instances and terms missing upstream are kept as ARCHIVED with ArchivedTs set.
+//   - Archived instances and terms appearing upstream again are restored to NORMAL.
+//   - UpdatedTs of an instance or a term is set to ts only if it is added, changed, archived or restored.
+//
+// The previous dataset is not modified, the merged list is sorted by Sort.
+func Merge(previousList, currentList []*DBInstance, ts int64) []*DBInstance {
+	previousMap := getDBInstanceMap(previousList)
+	currentMap := getDBInstanceMap(currentList)
+
+	var mergedList []*DBInstance
+	for externalID, current := range currentMap {
+		mergedList = append(mergedList, mergeDBInstance(previousMap[externalID], current, ts))
+	}
+	for externalID, previous := range previousMap {
+		if _, ok := currentMap[externalID]; ok {
+			continue
+		}
+		mergedList = append(mergedList, archiveDBInstance(previous, ts))
+	}
+
+	Sort(mergedList)
+	return mergedList
+}
+
+// mergeDBInstance merges the current instance with the previous one, previous is nil if the instance is new.
+func mergeDBInstance(previous, current *DBInstance, ts int64) *DBInstance {
+	merged := *current
+	merged.ExternalID = current.GetExternalID()
+	merged.RowStatus = RowStatusNormal
+	merged.ArchivedTs = 0
+	merged.UpdatedTs = ts
+	if previous == nil {
+		merged.RegionList = mergeRegionList(nil, current.RegionList, ts)
+		return &merged
+	}
+
+	merged.CreatorID = previous.CreatorID
+	merged.RegionList = mergeRegionList(previous.RegionList, current.RegionList, ts)
+	if !previous.IsArchived() && isDBInstanceSpecEqual(previous, current) && !isRegionListUpdated(merged.RegionList, ts) {
+		merged.UpdatedTs = previous.UpdatedTs
+	}
+	return &merged
+}
+
+func mergeRegionList(previousList, currentList []*Region, ts int64) []*Region {
+	previousMap := make(map[string]*Region)
+	for _, r := range previousList {
+		previousMap[r.Code] = r
+	}
+
+	var mergedList []*Region
+	currentCodeMap := make(map[string]bool)
+	for _, current := range currentList {
+		currentCodeMap[current.Code] = true
+		merged := *current
+		var previousTermList []*Term
+		if previous, ok := previousMap[current.Code]; ok {
+			previousTermList = previous.TermList
+		}
+		merged.TermList = mergeTermList(previousTermList, current.TermList, ts)
+		mergedList = append(mergedList, &merged)
+	}
+	for _, previous := range previousList {
+		if currentCodeMap[previous.Code] {
+			continue
+		}
+		merged := *previous
+		merged.TermList = mergeTermList(previous.TermList, nil, ts)
+		mergedList = append(mergedList, &merged)
+	}
+	return mergedList
+}
+
+func mergeTermList(previousList, currentList []*Term, ts int64) []*Term {
+	previousMap := make(map[string]*Term)
+	for _, term := range previousList {
+		previousMap[term.Code] = term
+	}
+
+	var mergedList []*Term
+	currentCodeMap := make(map[string]bool)
+	for _, current := range currentList {
+		currentCodeMap[current.Code] = true
+		merged := *current
+		merged.RowStatus = RowStatusNormal
+		merged.ArchivedTs = 0
+		merged.UpdatedTs = ts
+		if previous, ok := previousMap[current.Code]; ok && !previous.IsArchived() && isTermEqual(previous, current) {
+			merged.UpdatedTs = previous.UpdatedTs
+		}
+		mergedList = append(mergedList, &merged)
+	}
+	for _, previous := range previousList {
+		if currentCodeMap[previous.Code] {
+			continue
+		}
+		mergedList = append(mergedList, archiveTerm(previous, ts))
+	}
+	return mergedList
+}
+
+// archiveDBInstance archives the instance and all its terms, archived ones are kept as is.
+func archiveDBInstance(previous *DBInstance, ts int64) *DBInstance {
+	archived := *previous
+	archived.ExternalID = previous.GetExternalID()
+	if !previous.IsArchived() {
+		archived.RowStatus = RowStatusArchived
+		archived.ArchivedTs = ts
+		archived.UpdatedTs = ts
+	}
+	archived.RegionList = mergeRegionList(previous.RegionList, nil, ts)
+	return &archived
+}
+
+func archiveTerm(previous *Term, ts int64) *Term {
+	archived := *previous
+	if !previous.IsArchived() {
+		archived.RowStatus = RowStatusArchived
+		archived.ArchivedTs = ts
+		archived.UpdatedTs = ts
+	}
+	return &archived
+}
+
+// isRegionListUpdated returns true if any term in the merged region list is updated at ts.
+func isRegionListUpdated(regionList []*Region, ts int64) bool {
+	for _, r := range regionList {
+		for _, term := range r.TermList {
+			if term.UpdatedTs == ts {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isDBInstanceSpecEqual(a, b *DBInstance) bool {
+	return a.CPU == b.CPU && a.Memory == b.Memory && a.Processor == b.Processor &&
+		a.Family == b.Family && a.Series == b.Series && a.Size == b.Size && a.SizeRank == b.SizeRank &&
+		a.Architecture == b.Architecture
+}
+
+func isTermEqual(a, b *Term) bool {
+	if a.HourlyUSD != b.HourlyUSD || a.CommitmentUSD != b.CommitmentUSD ||
+		a.DatabaseEngine != b.DatabaseEngine || a.Type != b.Type {
+		return false
+	}
+	if a.Payload == nil || b.Payload == nil {
+		return a.Payload == b.Payload
+	}
+	return *a.Payload == *b.Payload
+}
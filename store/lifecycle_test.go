@@ -0,0 +1,74 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Merge(t *testing.T) {
+	newInstance := func(name string, termList ...*Term) *DBInstance {
+		return &DBInstance{
+			ExternalID:    getExternalID(CloudProviderAWS, name),
+			RowStatus:     RowStatusNormal,
+			CloudProvider: CloudProviderAWS,
+			Name:          name,
+			RegionList:    []*Region{{Code: "us-east-1", TermList: termList}},
+		}
+	}
+	newTerm := func(code string, hourlyUSD float64) *Term {
+		return &Term{Code: code, RowStatus: RowStatusNormal, DatabaseEngine: client.EngineTypeMySQL, Type: client.ChargeTypeOnDemand, HourlyUSD: hourlyUSD}
+	}
+
+	// the first run
+	mergedList := Merge(nil, []*DBInstance{
+		newInstance("db.m3.large", newTerm("A.a", 1)),
+		newInstance("db.m5.large", newTerm("B.a", 1), newTerm("B.b", 2)),
+	}, 100)
+	require.Len(t, mergedList, 2)
+	require.Equal(t, int64(100), mergedList[0].UpdatedTs)
+	require.Equal(t, int64(100), mergedList[1].RegionList[0].TermList[1].UpdatedTs)
+
+	// db.m3 is retired and B.b is removed.
+	mergedList = Merge(mergedList, []*DBInstance{
+		newInstance("db.m5.large", newTerm("B.a", 1)),
+	}, 200)
+	require.Len(t, mergedList, 2)
+	m3, m5 := mergedList[0], mergedList[1]
+	require.Equal(t, "AWS:db.m3.large", m3.ExternalID)
+	require.True(t, m3.IsArchived())
+	require.Equal(t, int64(200), m3.ArchivedTs)
+	require.True(t, m3.RegionList[0].TermList[0].IsArchived())
+	require.False(t, m5.IsArchived())
+	require.Equal(t, int64(200), m5.UpdatedTs)
+	require.Equal(t, int64(100), m5.RegionList[0].TermList[0].UpdatedTs)
+	require.True(t, m5.RegionList[0].TermList[1].IsArchived())
+	require.Equal(t, int64(200), m5.RegionList[0].TermList[1].ArchivedTs)
+
+	// nothing changed upstream, the archived timestamps are kept.
+	mergedList = Merge(mergedList, []*DBInstance{
+		newInstance("db.m5.large", newTerm("B.a", 1)),
+	}, 300)
+	m3, m5 = mergedList[0], mergedList[1]
+	require.Equal(t, int64(200), m3.ArchivedTs)
+	require.Equal(t, int64(200), m3.UpdatedTs)
+	require.Equal(t, int64(200), m5.UpdatedTs)
+	require.Equal(t, int64(200), m5.RegionList[0].TermList[1].ArchivedTs)
+
+	// db.m3 reappears and B.a changes its price.
+	mergedList = Merge(mergedList, []*DBInstance{
+		newInstance("db.m3.large", newTerm("A.a", 1)),
+		newInstance("db.m5.large", newTerm("B.a", 1.5)),
+	}, 400)
+	m3, m5 = mergedList[0], mergedList[1]
+	require.False(t, m3.IsArchived())
+	require.Equal(t, int64(0), m3.ArchivedTs)
+	require.Equal(t, int64(400), m3.UpdatedTs)
+	require.False(t, m3.RegionList[0].TermList[0].IsArchived())
+	require.Equal(t, int64(400), m5.RegionList[0].TermList[0].UpdatedTs)
+
+	// archived rows are considered as removed by Compare.
+	diff := Compare(mergedList, []*DBInstance{newInstance("db.m5.large", newTerm("B.a", 1.5))})
+	require.Len(t, diff.RemovedInstanceList, 1)
+}
@@ -0,0 +1,37 @@
+package store
+
+import "math"
+
+// memoryPerComputeUnit is the GiB of memory of a compute unit, as a general purpose instance usually has 4 GiB per vCPU.
+const memoryPerComputeUnit = 4
+
+// FillUnitPrice fills in the price-per-unit metrics of each term from the specification of its instance.
+// The metrics are derived from the effective hourly price, so that the on-demand and the reserved terms are comparable.
+//   - USDPerCPUHour is the effective hourly price divided by the vCPU.
+//   - USDPerGiBHour is the effective hourly price divided by the memory in GiB.
+//   - PricePerformanceIndex is the effective hourly price of a compute unit, which is 1 vCPU with 4 GiB,
+//     with the vCPU and the memory weighted equally. The lower the index, the more value for the money.
+//
+// A metric is 0 if the instance does not have the corresponding specification.
+func FillUnitPrice(dbInstanceList []*DBInstance) {
+	for _, dbInstance := range dbInstanceList {
+		cpu, memory := float64(dbInstance.CPU), dbInstance.GetMemoryGiB()
+		computeUnit := (cpu + memory/memoryPerComputeUnit) / 2
+		for _, region := range dbInstance.RegionList {
+			for _, term := range region.TermList {
+				effective := term.GetEffectiveHourlyUSD()
+				term.USDPerCPUHour = getUnitPrice(effective, cpu)
+				term.USDPerGiBHour = getUnitPrice(effective, memory)
+				term.PricePerformanceIndex = getUnitPrice(effective, computeUnit)
+			}
+		}
+	}
+}
+
+// getUnitPrice returns the price per unit rounded to 8 decimals, so that the output is stable across platforms.
+func getUnitPrice(price, unit float64) float64 {
+	if unit <= 0 {
+		return 0
+	}
+	return math.Round(price/unit*1e8) / 1e8
+}
@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Compression is the compression of an output variant.
+type Compression string
+
+const (
+	// CompressionGzip is the gzip variant, saved with the .gz extension.
+	CompressionGzip Compression = "gzip"
+	// CompressionBrotli is the brotli variant, saved with the .br extension.
+	CompressionBrotli Compression = "br"
+)
+
+// Extension returns the file extension of the compression.
+func (c Compression) Extension() string {
+	switch c {
+	case CompressionGzip:
+		return ".gz"
+	case CompressionBrotli:
+		return ".br"
+	}
+	return ""
+}
+
+// ParseCompressionList parses the comma separated compression list, e.g. gzip,br.
+func ParseCompressionList(s string) ([]Compression, error) {
+	var compressionList []Compression
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		compression := Compression(item)
+		if compression.Extension() == "" {
+			return nil, fmt.Errorf("unknown compression %q, allowed compressions are gzip and br", item)
+		}
+		compressionList = append(compressionList, compression)
+	}
+	return compressionList, nil
+}
+
+// compress returns the data compressed with the best ratio, as the output is written once and served many times.
+func compress(dataByted []byte, compression Compression) ([]byte, error) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch compression {
+	case CompressionGzip:
+		gzipWriter, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+		writer = gzipWriter
+	case CompressionBrotli:
+		writer = brotli.NewWriterLevel(&buf, brotli.BestCompression)
+	default:
+		return nil, fmt.Errorf("unknown compression %q", compression)
+	}
+	if _, err := writer.Write(dataByted); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress returns the data decompressed according to the extension of the file, the data is returned as is if not compressed.
+func decompress(filePath string, dataByted []byte) ([]byte, error) {
+	var reader io.Reader
+	switch filepath.Ext(filePath) {
+	case CompressionGzip.Extension():
+		gzipReader, err := gzip.NewReader(bytes.NewReader(dataByted))
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case CompressionBrotli.Extension():
+		reader = brotli.NewReader(bytes.NewReader(dataByted))
+	default:
+		return dataByted, nil
+	}
+	return io.ReadAll(reader)
+}
+
+// writeFile writes the data to filePath with the compressed variants next to it, e.g. dbInstance.json.gz.
+// Each file is written atomically, so that readers never see a truncated file.
+func writeFile(filePath string, dataByted []byte, compressionList []Compression) error {
+	if err := WriteFileAtomic(filePath, dataByted); err != nil {
+		return err
+	}
+	for _, compression := range compressionList {
+		compressed, err := compress(dataByted, compression)
+		if err != nil {
+			return fmt.Errorf("Fail to compress %s with %s, [internal]: %v", filePath, compression, err)
+		}
+		if err := WriteFileAtomic(filePath+compression.Extension(), compressed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteFileAtomic writes the data to a temp file in the same directory and renames it to filePath,
+// the rename is atomic as long as both files are on the same file system.
+func WriteFileAtomic(filePath string, dataByted []byte) (err error) {
+	fd, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			fd.Close()
+			os.Remove(fd.Name())
+		}
+	}()
+
+	if _, err = fd.Write(dataByted); err != nil {
+		return err
+	}
+	// flush to disk before renaming, otherwise a crash may leave an empty file after the rename.
+	if err = fd.Sync(); err != nil {
+		return err
+	}
+	if err = fd.Chmod(0644); err != nil {
+		return err
+	}
+	if err = fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fd.Name(), filePath)
+}
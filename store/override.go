@@ -0,0 +1,343 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytebase/dbcost/taxonomy"
+	"gopkg.in/yaml.v3"
+)
+
+// OverrideAction is the action of a manual override.
+type OverrideAction string
+
+const (
+	// OverrideActionPatchPrice patches the price of a term.
+	OverrideActionPatchPrice OverrideAction = "PATCH_PRICE"
+	// OverrideActionRenameRegion renames the region code of an instance, or of all the instances of a provider.
+	OverrideActionRenameRegion OverrideAction = "RENAME_REGION"
+	// OverrideActionHideInstance removes an instance from the data.
+	OverrideActionHideInstance OverrideAction = "HIDE_INSTANCE"
+	// OverrideActionAddInstance adds an instance missing upstream.
+	OverrideActionAddInstance OverrideAction = "ADD_INSTANCE"
+)
+
+// Override is a manual correction contributed by a human to the upstream data.
+type Override struct {
+	// ContributorID is the contributor of the override, rows touched by the override are updated by this contributor.
+	ContributorID int            `json:"contributorId"`
+	Action        OverrideAction `json:"action"`
+	// Reason is why the override is needed, e.g. a link to the upstream issue.
+	Reason string `json:"reason"`
+
+	// CloudProvider, ExternalID, RegionCode and TermCode locate the row to override.
+	// CloudProvider is only required by RENAME_REGION without ExternalID, which renames the region of all the instances of the provider.
+	CloudProvider string `json:"cloudProvider,omitempty"`
+	ExternalID    string `json:"externalId,omitempty"`
+	RegionCode    string `json:"regionCode,omitempty"`
+	TermCode      string `json:"termCode,omitempty"`
+
+	// HourlyUSD and CommitmentUSD are the prices to patch, nil if not patched.
+	HourlyUSD     *float64 `json:"hourlyUSD,omitempty"`
+	CommitmentUSD *float64 `json:"commitmentUSD,omitempty"`
+	// NewRegionCode is the region code renamed to.
+	NewRegionCode string `json:"newRegionCode,omitempty"`
+	// DBInstance is the instance to add.
+	DBInstance *DBInstance `json:"dbInstance,omitempty"`
+}
+
+// StaleOverride is an override that no longer matches the data, which should be removed from the override file.
+type StaleOverride struct {
+	// Index is the position of the override in the override list.
+	Index    int       `json:"index"`
+	Override *Override `json:"override"`
+	Reason   string    `json:"reason"`
+}
+
+// GetCloudProvider returns the provider of the data the override applies to.
+func (o *Override) GetCloudProvider() string {
+	if o.CloudProvider != "" {
+		return o.CloudProvider
+	}
+	if o.DBInstance != nil {
+		return o.DBInstance.CloudProvider
+	}
+	if provider, _, ok := strings.Cut(o.ExternalID, ":"); ok {
+		return provider
+	}
+	return ""
+}
+
+// LoadOverride loads the override list from local .json, .yaml or .yml file, nil is returned if the file does not exist.
+func LoadOverride(filePath string) ([]*Override, error) {
+	dataByted, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".yaml", ".yml":
+		// The YAML is converted to JSON so that the overrides share the json tags of the store types.
+		var raw interface{}
+		if err := yaml.Unmarshal(dataByted, &raw); err != nil {
+			return nil, fmt.Errorf("Fail to unmarshal the override file %s, [internal]: %v", filePath, err)
+		}
+		if dataByted, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("Fail to convert the override file %s to JSON, [internal]: %v", filePath, err)
+		}
+	}
+
+	var overrideList []*Override
+	if err := json.Unmarshal(dataByted, &overrideList); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the override file %s, [internal]: %v", filePath, err)
+	}
+	return overrideList, nil
+}
+
+// Validate checks the override is well-formed regardless of the data it applies to.
+func (o *Override) Validate() error {
+	if o.ContributorID == SYSTEM_BOT {
+		return fmt.Errorf("override should be contributed by a human rather than the system bot")
+	}
+	switch o.Action {
+	case OverrideActionPatchPrice:
+		if o.ExternalID == "" || o.RegionCode == "" || o.TermCode == "" {
+			return fmt.Errorf("%s requires externalId, regionCode and termCode", o.Action)
+		}
+		if o.HourlyUSD == nil && o.CommitmentUSD == nil {
+			return fmt.Errorf("%s requires hourlyUSD or commitmentUSD", o.Action)
+		}
+		if (o.HourlyUSD != nil && *o.HourlyUSD < 0) || (o.CommitmentUSD != nil && *o.CommitmentUSD < 0) {
+			return fmt.Errorf("%s requires non-negative prices", o.Action)
+		}
+	case OverrideActionRenameRegion:
+		if o.ExternalID == "" && o.CloudProvider == "" {
+			return fmt.Errorf("%s requires externalId or cloudProvider", o.Action)
+		}
+		if o.RegionCode == "" || o.NewRegionCode == "" || o.RegionCode == o.NewRegionCode {
+			return fmt.Errorf("%s requires different regionCode and newRegionCode", o.Action)
+		}
+	case OverrideActionHideInstance:
+		if o.ExternalID == "" {
+			return fmt.Errorf("%s requires externalId", o.Action)
+		}
+	case OverrideActionAddInstance:
+		if o.DBInstance == nil || o.DBInstance.CloudProvider == "" || o.DBInstance.Name == "" {
+			return fmt.Errorf("%s requires dbInstance with cloudProvider and name", o.Action)
+		}
+		if externalID := getExternalID(CloudProvider(o.DBInstance.CloudProvider), o.DBInstance.Name); o.ExternalID != "" && o.ExternalID != externalID {
+			return fmt.Errorf("externalId %s mismatches the dbInstance %s", o.ExternalID, externalID)
+		}
+	default:
+		return fmt.Errorf("unknown action %q", o.Action)
+	}
+	return nil
+}
+
+// ApplyOverride applies the override list to the dbInstanceList in order, and returns the overridden list.
+// The UpdaterID of the instance touched by an override is set to the contributor of the override.
+// An error is returned if any override is malformed, while overrides not matching the data are returned as stale and skipped.
+// The instances and terms in dbInstanceList may be modified in place.
+func ApplyOverride(dbInstanceList []*DBInstance, overrideList []*Override) ([]*DBInstance, []*StaleOverride, error) {
+	for i, override := range overrideList {
+		if err := override.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("override #%d: %v", i, err)
+		}
+	}
+
+	var staleList []*StaleOverride
+	for i, override := range overrideList {
+		var reason string
+		dbInstanceList, reason = applyOverride(dbInstanceList, override)
+		if reason != "" {
+			staleList = append(staleList, &StaleOverride{Index: i, Override: override, Reason: reason})
+		}
+	}
+	// the patched prices and the added instances need the metrics refreshed.
+	FillUnitPrice(dbInstanceList)
+	return dbInstanceList, staleList, nil
+}
+
+// applyOverride applies a single override, the reason is returned if the override is stale.
+func applyOverride(dbInstanceList []*DBInstance, override *Override) ([]*DBInstance, string) {
+	switch override.Action {
+	case OverrideActionPatchPrice:
+		dbInstance := findDBInstance(dbInstanceList, override.ExternalID)
+		if dbInstance == nil {
+			return dbInstanceList, fmt.Sprintf("instance %s not found", override.ExternalID)
+		}
+		term := findTerm(dbInstance, override.RegionCode, override.TermCode)
+		if term == nil {
+			return dbInstanceList, fmt.Sprintf("term %s of %s in %s not found", override.TermCode, override.ExternalID, override.RegionCode)
+		}
+		if (override.HourlyUSD == nil || *override.HourlyUSD == term.HourlyUSD) &&
+			(override.CommitmentUSD == nil || *override.CommitmentUSD == term.CommitmentUSD) {
+			return dbInstanceList, fmt.Sprintf("term %s of %s in %s already has the patched price", override.TermCode, override.ExternalID, override.RegionCode)
+		}
+		if override.HourlyUSD != nil {
+			term.HourlyUSD = *override.HourlyUSD
+		}
+		if override.CommitmentUSD != nil {
+			term.CommitmentUSD = *override.CommitmentUSD
+		}
+		dbInstance.UpdaterID = override.ContributorID
+
+	case OverrideActionRenameRegion:
+		renamed := false
+		for _, dbInstance := range dbInstanceList {
+			if override.ExternalID != "" && dbInstance.GetExternalID() != override.ExternalID {
+				continue
+			}
+			if override.CloudProvider != "" && dbInstance.CloudProvider != override.CloudProvider {
+				continue
+			}
+			if renameRegion(dbInstance, override.RegionCode, override.NewRegionCode) {
+				dbInstance.UpdaterID = override.ContributorID
+				renamed = true
+			}
+		}
+		if !renamed {
+			return dbInstanceList, fmt.Sprintf("region %s not found", override.RegionCode)
+		}
+
+	case OverrideActionHideInstance:
+		var filteredList []*DBInstance
+		for _, dbInstance := range dbInstanceList {
+			if dbInstance.GetExternalID() != override.ExternalID {
+				filteredList = append(filteredList, dbInstance)
+			}
+		}
+		if len(filteredList) == len(dbInstanceList) {
+			return dbInstanceList, fmt.Sprintf("instance %s not found", override.ExternalID)
+		}
+		return filteredList, ""
+
+	case OverrideActionAddInstance:
+		cloudProvider := CloudProvider(override.DBInstance.CloudProvider)
+		externalID := getExternalID(cloudProvider, override.DBInstance.Name)
+		if findDBInstance(dbInstanceList, externalID) != nil {
+			return dbInstanceList, fmt.Sprintf("instance %s is provided upstream", externalID)
+		}
+		return append(dbInstanceList, newOverrideDBInstance(override, cloudProvider, externalID)), ""
+	}
+	return dbInstanceList, ""
+}
+
+// ExcludeHidden returns the instances not hidden by the HIDE_INSTANCE overrides.
+// It is applied to the previous data before merging, otherwise a hidden instance would be archived rather than removed.
+func ExcludeHidden(dbInstanceList []*DBInstance, overrideList []*Override) []*DBInstance {
+	hiddenMap := make(map[string]bool)
+	for _, override := range overrideList {
+		if override.Action == OverrideActionHideInstance {
+			hiddenMap[override.ExternalID] = true
+		}
+	}
+	var filteredList []*DBInstance
+	for _, dbInstance := range dbInstanceList {
+		if !hiddenMap[dbInstance.GetExternalID()] {
+			filteredList = append(filteredList, dbInstance)
+		}
+	}
+	return filteredList
+}
+
+// renameRegion renames the region of the instance, the terms are merged into the region renamed to if it exists.
+func renameRegion(dbInstance *DBInstance, code, newCode string) bool {
+	renamed := newRegion(newCode, CloudProvider(dbInstance.CloudProvider))
+	if renamed.Code == code {
+		return false
+	}
+	var source, target *Region
+	for _, r := range dbInstance.RegionList {
+		switch r.Code {
+		case code:
+			source = r
+		case renamed.Code:
+			target = r
+		}
+	}
+	if source == nil {
+		return false
+	}
+
+	if target == nil {
+		renamed.TermList = source.TermList
+		*source = *renamed
+		return true
+	}
+	for _, term := range source.TermList {
+		if findTerm(dbInstance, renamed.Code, term.Code) == nil {
+			target.TermList = append(target.TermList, term)
+		}
+	}
+	var regionList []*Region
+	for _, r := range dbInstance.RegionList {
+		if r != source {
+			regionList = append(regionList, r)
+		}
+	}
+	dbInstance.RegionList = regionList
+	return true
+}
+
+// newOverrideDBInstance builds the instance added by the override, the system fields are filled in as Convert does.
+func newOverrideDBInstance(override *Override, cloudProvider CloudProvider, externalID string) *DBInstance {
+	dbInstance := *override.DBInstance
+	dbInstance.ID = GetID(externalID)
+	dbInstance.ExternalID = externalID
+	dbInstance.RowStatus = RowStatusNormal
+	dbInstance.CreatorID = override.ContributorID
+	dbInstance.UpdaterID = override.ContributorID
+	if dbInstance.Family == "" {
+		if class := taxonomy.Classify(cloudProvider.String(), dbInstance.Name, dbInstance.CPU); class != nil {
+			dbInstance.Family = class.Family
+			dbInstance.Series = class.Series
+			dbInstance.Size = class.Size
+			dbInstance.SizeRank = class.SizeRank
+			dbInstance.Architecture = class.Architecture
+		}
+	}
+
+	var regionList []*Region
+	for _, r := range override.DBInstance.RegionList {
+		normalized := newRegion(r.Code, cloudProvider)
+		for _, term := range r.TermList {
+			t := *term
+			t.RowStatus = RowStatusNormal
+			normalized.TermList = append(normalized.TermList, &t)
+		}
+		regionList = append(regionList, normalized)
+	}
+	dbInstance.RegionList = regionList
+	return &dbInstance
+}
+
+func findDBInstance(dbInstanceList []*DBInstance, externalID string) *DBInstance {
+	for _, dbInstance := range dbInstanceList {
+		if dbInstance.GetExternalID() == externalID {
+			return dbInstance
+		}
+	}
+	return nil
+}
+
+func findTerm(dbInstance *DBInstance, regionCode, termCode string) *Term {
+	for _, region := range dbInstance.RegionList {
+		if region.Code != regionCode {
+			continue
+		}
+		for _, term := range region.TermList {
+			if term.Code == termCode {
+				return term
+			}
+		}
+	}
+	return nil
+}
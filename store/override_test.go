@@ -0,0 +1,175 @@
+package store
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/bytebase/dbcost/taxonomy"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyOverride(t *testing.T) {
+	dbInstanceList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+
+	hourlyUSD := 1.0
+	dbInstanceList, staleList, err := ApplyOverride(dbInstanceList, []*Override{{
+		ContributorID: 101,
+		Action:        OverrideActionPatchPrice,
+		ExternalID:    "AWS:db.r6g.4xlarge",
+		RegionCode:    "us-east-1",
+		TermCode:      "AAA.a",
+		HourlyUSD:     &hourlyUSD,
+	}})
+	require.NoError(t, err)
+	require.Empty(t, staleList)
+	require.Equal(t, 101, dbInstanceList[0].UpdaterID)
+	require.Equal(t, SYSTEM_BOT, dbInstanceList[0].CreatorID)
+	require.Equal(t, 1.0, findTerm(dbInstanceList[0], "us-east-1", "AAA.a").HourlyUSD)
+
+	// overrides should be contributed by humans.
+	_, _, err = ApplyOverride(dbInstanceList, []*Override{{ContributorID: SYSTEM_BOT, Action: OverrideActionHideInstance, ExternalID: "AWS:db.r6g.4xlarge"}})
+	require.Error(t, err)
+	_, _, err = ApplyOverride(dbInstanceList, []*Override{{ContributorID: 101, Action: "UNKNOWN", ExternalID: "AWS:db.r6g.4xlarge"}})
+	require.Error(t, err)
+}
+
+func Test_ApplyOverrideAction(t *testing.T) {
+	dbInstanceList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+
+	dbInstanceList, staleList, err := ApplyOverride(dbInstanceList, []*Override{
+		{
+			ContributorID: 101,
+			Action:        OverrideActionRenameRegion,
+			CloudProvider: CloudProviderAWS,
+			RegionCode:    "ap-south-1",
+			NewRegionCode: "US East (N. Virginia)",
+		},
+		{
+			ContributorID: 102,
+			Action:        OverrideActionHideInstance,
+			ExternalID:    "GCP:db-N1Standard-96-360",
+		},
+		{
+			ContributorID: 103,
+			Action:        OverrideActionAddInstance,
+			DBInstance: &DBInstance{
+				CloudProvider: CloudProviderAWS,
+				Name:          "db.r6g.large",
+				CPU:           2,
+				Memory:        "16",
+				RegionList: []*Region{{
+					Code:     "Europe (Frankfurt)",
+					TermList: []*Term{{Code: "CCC.a", Type: "OnDemand", DatabaseEngine: "MYSQL", HourlyUSD: 0.2}},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, staleList)
+	require.Len(t, dbInstanceList, 2)
+
+	// the region renamed to is normalized to the provider code, and the terms are merged into it.
+	renamed := dbInstanceList[0]
+	require.Equal(t, 101, renamed.UpdaterID)
+	require.Len(t, renamed.RegionList, 1)
+	require.Equal(t, "us-east-1", renamed.RegionList[0].Code)
+	require.Len(t, renamed.RegionList[0].TermList, 24)
+	require.NotNil(t, findTerm(renamed, "us-east-1", "BBB.a"))
+
+	added := dbInstanceList[1]
+	require.Equal(t, "AWS:db.r6g.large", added.ExternalID)
+	require.Equal(t, GetID("AWS:db.r6g.large"), added.ID)
+	require.Equal(t, 103, added.CreatorID)
+	require.Equal(t, RowStatusNormal, added.RowStatus)
+	require.Equal(t, taxonomy.FamilyMemoryOptimized, added.Family)
+	require.Equal(t, "eu-central-1", added.RegionList[0].Code)
+	require.Equal(t, "europe-frankfurt", added.RegionList[0].Slug)
+	require.Equal(t, RowStatusNormal, added.RegionList[0].TermList[0].RowStatus)
+}
+
+func Test_HideInstanceInPreviousData(t *testing.T) {
+	previousList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+	currentList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+
+	overrideList := []*Override{{ContributorID: 101, Action: OverrideActionHideInstance, ExternalID: "AWS:db.r6g.4xlarge"}}
+	currentList, staleList, err := ApplyOverride(currentList, overrideList)
+	require.NoError(t, err)
+	require.Empty(t, staleList)
+
+	// the hidden instance is removed rather than archived.
+	mergedList := Merge(ExcludeHidden(previousList, overrideList), currentList, 100)
+	require.Len(t, mergedList, 1)
+	require.Equal(t, "GCP:db-N1Standard-96-360", mergedList[0].GetExternalID())
+	require.False(t, mergedList[0].IsArchived())
+
+	// without excluding, the hidden instance would be kept as archived.
+	mergedList = Merge(previousList, currentList, 100)
+	require.Len(t, mergedList, 2)
+	require.True(t, mergedList[0].IsArchived())
+}
+
+func Test_ApplyOverrideStale(t *testing.T) {
+	dbInstanceList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+
+	hourlyUSD := findTerm(dbInstanceList[0], "us-east-1", "AAA.a").HourlyUSD
+	dbInstanceList, staleList, err := ApplyOverride(dbInstanceList, []*Override{
+		// upstream has fixed the price.
+		{ContributorID: 101, Action: OverrideActionPatchPrice, ExternalID: "AWS:db.r6g.4xlarge", RegionCode: "us-east-1", TermCode: "AAA.a", HourlyUSD: &hourlyUSD},
+		{ContributorID: 101, Action: OverrideActionPatchPrice, ExternalID: "AWS:db.r6g.4xlarge", RegionCode: "us-east-1", TermCode: "ZZZ", HourlyUSD: &hourlyUSD},
+		{ContributorID: 101, Action: OverrideActionRenameRegion, ExternalID: "AWS:db.r6g.4xlarge", RegionCode: "Asia Pacific (Mumbai)", NewRegionCode: "ap-south-1"},
+		{ContributorID: 101, Action: OverrideActionHideInstance, ExternalID: "AWS:db.m1.small"},
+		// upstream provides the instance now.
+		{ContributorID: 101, Action: OverrideActionAddInstance, DBInstance: &DBInstance{CloudProvider: CloudProviderGCP, Name: "db-N1Standard-96-360"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, dbInstanceList, 2)
+	require.Len(t, staleList, 5)
+	for i, stale := range staleList {
+		require.Equal(t, i, stale.Index)
+		require.NotEmpty(t, stale.Reason)
+	}
+	require.Equal(t, SYSTEM_BOT, dbInstanceList[0].UpdaterID)
+}
+
+func Test_LoadOverrideYAML(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "override.yaml")
+	err := os.WriteFile(filePath, []byte(`
+- contributorId: 101
+  action: PATCH_PRICE
+  reason: the upstream price is wrong
+  externalId: AWS:db.r6g.4xlarge
+  regionCode: us-east-1
+  termCode: AAA.a
+  hourlyUSD: 1.5
+- contributorId: 102
+  action: ADD_INSTANCE
+  dbInstance:
+    cloudProvider: AWS
+    name: db.r6g.large
+    regionList:
+      - code: us-east-1
+        termList:
+          - code: CCC.a
+            hourlyUSD: 0.2
+`), 0644)
+	require.NoError(t, err)
+
+	overrideList, err := LoadOverride(filePath)
+	require.NoError(t, err)
+	require.Len(t, overrideList, 2)
+	require.Equal(t, OverrideActionPatchPrice, overrideList[0].Action)
+	require.Equal(t, 1.5, *overrideList[0].HourlyUSD)
+	require.Nil(t, overrideList[0].CommitmentUSD)
+	require.Equal(t, "AWS", overrideList[1].GetCloudProvider())
+	require.Equal(t, 0.2, overrideList[1].DBInstance.RegionList[0].TermList[0].HourlyUSD)
+
+	overrideList, err = LoadOverride(path.Join(t.TempDir(), "override.json"))
+	require.NoError(t, err)
+	require.Nil(t, overrideList)
+}
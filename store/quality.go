@@ -0,0 +1,281 @@
+package store
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+)
+
+// QualityConfig is the thresholds of the data quality rules.
+type QualityConfig struct {
+	// MaxPriceChangePercent is the max absolute change percent of a price compared to the previous snapshot.
+	MaxPriceChangePercent float64
+	// MaxInstanceDropPercent is the max percent of the instances of a provider dropped compared to the previous snapshot.
+	MaxInstanceDropPercent float64
+}
+
+// DefaultQualityConfig is the thresholds used by the seed.
+var DefaultQualityConfig = &QualityConfig{
+	MaxPriceChangePercent:  50,
+	MaxInstanceDropPercent: 10,
+}
+
+// QualityIssue is a violation of a data quality rule.
+type QualityIssue struct {
+	Rule       string `json:"rule"`
+	ExternalID string `json:"externalId,omitempty"`
+	RegionCode string `json:"regionCode,omitempty"`
+	TermCode   string `json:"termCode,omitempty"`
+	Message    string `json:"message"`
+}
+
+// QualityInput is the data checked by the rules, archived instances and terms are ignored by the rules.
+type QualityInput struct {
+	// PreviousList is the previous snapshot, nil if there is no previous snapshot.
+	PreviousList []*DBInstance
+	CurrentList  []*DBInstance
+}
+
+// QualityRule is a data quality rule checked before publishing the data.
+type QualityRule interface {
+	// Name is the identifier of the rule, e.g. on-demand-price.
+	Name() string
+	Check(input *QualityInput) []*QualityIssue
+}
+
+// GetQualityRuleList returns the rules with the thresholds in config.
+func GetQualityRuleList(config *QualityConfig) []QualityRule {
+	return []QualityRule{
+		&regionRule{},
+		&onDemandPriceRule{},
+		&reservedPriceRule{},
+		&priceChangeRule{maxPercent: config.MaxPriceChangePercent},
+		&instanceCountRule{maxDropPercent: config.MaxInstanceDropPercent},
+	}
+}
+
+// QualityReport is the issues found by the rules.
+type QualityReport struct {
+	IssueList []*QualityIssue `json:"issueList"`
+}
+
+// CheckQuality checks the input against the rules in order.
+func CheckQuality(input *QualityInput, ruleList []QualityRule) *QualityReport {
+	report := &QualityReport{}
+	for _, rule := range ruleList {
+		report.IssueList = append(report.IssueList, rule.Check(input)...)
+	}
+	return report
+}
+
+// IsEmpty returns true if no issue is found.
+func (r *QualityReport) IsEmpty() bool {
+	return len(r.IssueList) == 0
+}
+
+// Markdown renders the report as markdown.
+func (r *QualityReport) Markdown() string {
+	var b strings.Builder
+	b.WriteString("## Data quality check\n\n")
+	if r.IsEmpty() {
+		b.WriteString("No issues.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "%d issues found.\n\n", len(r.IssueList))
+	b.WriteString("| Rule | Instance | Region | Term | Message |\n| --- | --- | --- | --- | --- |\n")
+	for i, issue := range r.IssueList {
+		if i == markdownMaxRow {
+			writeTruncated(&b, len(r.IssueList)-markdownMaxRow)
+			break
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", issue.Rule, issue.ExternalID, issue.RegionCode, issue.TermCode, issue.Message)
+	}
+	return b.String()
+}
+
+// regionRule checks each instance is provided in at least one region.
+type regionRule struct{}
+
+func (*regionRule) Name() string { return "region" }
+
+func (r *regionRule) Check(input *QualityInput) []*QualityIssue {
+	var issueList []*QualityIssue
+	for _, dbInstance := range input.CurrentList {
+		if dbInstance.IsArchived() {
+			continue
+		}
+		if len(getActiveRegionMap(dbInstance.RegionList)) == 0 {
+			issueList = append(issueList, &QualityIssue{
+				Rule:       r.Name(),
+				ExternalID: dbInstance.GetExternalID(),
+				Message:    "the instance is not provided in any region",
+			})
+		}
+	}
+	return issueList
+}
+
+// onDemandPriceRule checks the hourly price of each on-demand term is positive.
+type onDemandPriceRule struct{}
+
+func (*onDemandPriceRule) Name() string { return "on-demand-price" }
+
+func (r *onDemandPriceRule) Check(input *QualityInput) []*QualityIssue {
+	var issueList []*QualityIssue
+	forEachActiveTerm(input.CurrentList, func(dbInstance *DBInstance, region *Region, term *Term) {
+		if term.Type == client.ChargeTypeOnDemand && term.HourlyUSD <= 0 {
+			issueList = append(issueList, &QualityIssue{
+				Rule:       r.Name(),
+				ExternalID: dbInstance.GetExternalID(),
+				RegionCode: region.Code,
+				TermCode:   term.Code,
+				Message:    fmt.Sprintf("the hourly price %v of the on-demand term should be positive", term.HourlyUSD),
+			})
+		}
+	})
+	return issueList
+}
+
+// reservedPriceRule checks the effective hourly price of each reserved term is lower than the on-demand one of the same engine.
+// The highest on-demand price of the engine in the region is compared, as an engine is not guaranteed to have a single on-demand term in a region.
+type reservedPriceRule struct{}
+
+func (*reservedPriceRule) Name() string { return "reserved-price" }
+
+func (r *reservedPriceRule) Check(input *QualityInput) []*QualityIssue {
+	var issueList []*QualityIssue
+	for _, dbInstance := range input.CurrentList {
+		if dbInstance.IsArchived() {
+			continue
+		}
+		for _, region := range dbInstance.RegionList {
+			onDemandMap := make(map[client.EngineType]float64)
+			for _, term := range getActiveTermList(region.TermList) {
+				if term.Type == client.ChargeTypeOnDemand {
+					onDemandMap[term.DatabaseEngine] = math.Max(onDemandMap[term.DatabaseEngine], term.HourlyUSD)
+				}
+			}
+			for _, term := range getActiveTermList(region.TermList) {
+				onDemand := onDemandMap[term.DatabaseEngine]
+				// the terms without a valid on-demand price to compare are skipped, the on-demand ones are reported by onDemandPriceRule.
+				if term.Type != client.ChargeTypeReserved || onDemand <= 0 {
+					continue
+				}
+				if effective := term.GetEffectiveHourlyUSD(); effective >= onDemand {
+					issueList = append(issueList, &QualityIssue{
+						Rule:       r.Name(),
+						ExternalID: dbInstance.GetExternalID(),
+						RegionCode: region.Code,
+						TermCode:   term.Code,
+						Message:    fmt.Sprintf("the effective hourly price %.4f of the reserved term should be lower than the on-demand %v", effective, onDemand),
+					})
+				}
+			}
+		}
+	}
+	return issueList
+}
+
+// priceChangeRule checks the price change of each term compared to the previous snapshot is within the threshold.
+type priceChangeRule struct {
+	maxPercent float64
+}
+
+func (*priceChangeRule) Name() string { return "price-change" }
+
+func (r *priceChangeRule) Check(input *QualityInput) []*QualityIssue {
+	var issueList []*QualityIssue
+	for _, change := range Compare(input.PreviousList, input.CurrentList).PriceChangeList {
+		if !exceedChange(change.OldHourlyUSD, change.NewHourlyUSD, r.maxPercent) && !exceedChange(change.OldCommitmentUSD, change.NewCommitmentUSD, r.maxPercent) {
+			continue
+		}
+		issueList = append(issueList, &QualityIssue{
+			Rule:       r.Name(),
+			ExternalID: change.ExternalID,
+			RegionCode: change.RegionCode,
+			TermCode:   change.TermCode,
+			Message: fmt.Sprintf("the price changed by hourly %s and commitment %s, exceeding ±%v%%",
+				formatQualityChange(change.OldHourlyUSD, change.NewHourlyUSD, change.HourlyChangePercent),
+				formatQualityChange(change.OldCommitmentUSD, change.NewCommitmentUSD, change.CommitmentChangePercent), r.maxPercent),
+		})
+	}
+	return issueList
+}
+
+// exceedChange returns true if the change from oldVal to newVal exceeds ±maxPercent.
+// The change percent is 0 if the old value is 0, so a change from 0 to non-zero, e.g. a commitment appearing, always exceeds it.
+func exceedChange(oldVal, newVal, maxPercent float64) bool {
+	if oldVal == 0 {
+		return newVal != 0
+	}
+	return math.Abs(getChangePercent(oldVal, newVal)) > maxPercent
+}
+
+// formatQualityChange formats the change percent, or the new value if the change is from 0.
+func formatQualityChange(oldVal, newVal, percent float64) string {
+	if oldVal == 0 && newVal != 0 {
+		return fmt.Sprintf("from $0 to $%v", newVal)
+	}
+	return formatPercent(percent)
+}
+
+// instanceCountRule checks the count of the instances of each provider does not drop by more than the threshold.
+type instanceCountRule struct {
+	maxDropPercent float64
+}
+
+func (*instanceCountRule) Name() string { return "instance-count" }
+
+func (r *instanceCountRule) Check(input *QualityInput) []*QualityIssue {
+	previousCountMap := getActiveInstanceCountMap(input.PreviousList)
+	currentCountMap := getActiveInstanceCountMap(input.CurrentList)
+
+	var issueList []*QualityIssue
+	for _, cloudProvider := range getSortedKey(previousCountMap) {
+		previous, current := previousCountMap[cloudProvider], currentCountMap[cloudProvider]
+		if dropPercent := -getChangePercent(float64(previous), float64(current)); dropPercent > r.maxDropPercent {
+			issueList = append(issueList, &QualityIssue{
+				Rule:    r.Name(),
+				Message: fmt.Sprintf("the instance count of %s dropped by %.2f%% from %d to %d, exceeding %v%%", cloudProvider, dropPercent, previous, current, r.maxDropPercent),
+			})
+		}
+	}
+	return issueList
+}
+
+func getActiveInstanceCountMap(dbInstanceList []*DBInstance) map[string]int {
+	countMap := make(map[string]int)
+	for _, dbInstance := range dbInstanceList {
+		if !dbInstance.IsArchived() {
+			countMap[dbInstance.CloudProvider]++
+		}
+	}
+	return countMap
+}
+
+// getActiveTermList returns the terms that are not archived in order.
+func getActiveTermList(termList []*Term) []*Term {
+	var activeList []*Term
+	for _, term := range termList {
+		if !term.IsArchived() {
+			activeList = append(activeList, term)
+		}
+	}
+	return activeList
+}
+
+// forEachActiveTerm calls fn on each term not archived in order.
+func forEachActiveTerm(dbInstanceList []*DBInstance, fn func(dbInstance *DBInstance, region *Region, term *Term)) {
+	for _, dbInstance := range dbInstanceList {
+		if dbInstance.IsArchived() {
+			continue
+		}
+		for _, region := range dbInstance.RegionList {
+			for _, term := range getActiveTermList(region.TermList) {
+				fn(dbInstance, region, term)
+			}
+		}
+	}
+}
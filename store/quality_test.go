@@ -0,0 +1,64 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckQuality(t *testing.T) {
+	previousList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+	currentList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+
+	ruleList := GetQualityRuleList(DefaultQualityConfig)
+	report := CheckQuality(&QualityInput{PreviousList: previousList, CurrentList: currentList}, ruleList)
+	require.True(t, report.IsEmpty(), report.Markdown())
+
+	// a zero-priced on-demand term caused by a parsing bug.
+	onDemand := findTerm(currentList[0], "us-east-1", "AAA.a")
+	require.Equal(t, "OnDemand", string(onDemand.Type))
+	onDemand.HourlyUSD = 0
+	// a reserved term more expensive than on-demand.
+	reserved := findTerm(currentList[0], "ap-south-1", "BBB.c")
+	require.Equal(t, "Reserved", string(reserved.Type))
+	reserved.CommitmentUSD = 100000
+	// the GCP instance is dropped.
+	currentList = currentList[:1]
+
+	report = CheckQuality(&QualityInput{PreviousList: previousList, CurrentList: currentList}, ruleList)
+	var ruleNameList []string
+	for _, issue := range report.IssueList {
+		ruleNameList = append(ruleNameList, issue.Rule)
+	}
+	require.Equal(t, []string{"on-demand-price", "reserved-price", "price-change", "price-change", "instance-count"}, ruleNameList)
+	require.Equal(t, "AWS:db.r6g.4xlarge", report.IssueList[0].ExternalID)
+	require.Equal(t, "AAA.a", report.IssueList[0].TermCode)
+	require.Equal(t, "the instance count of GCP dropped by 100.00% from 1 to 0, exceeding 10%", report.IssueList[4].Message)
+	require.Contains(t, report.Markdown(), "5 issues found.")
+
+	// a commitment appearing from nothing is flagged, though its change percent is 0.
+	previousList, err = Load("../data/sample.json")
+	require.NoError(t, err)
+	changedList, err := Load("../data/sample.json")
+	require.NoError(t, err)
+	noUpfront := findTerm(changedList[0], "us-east-1", "AAA.d")
+	require.Equal(t, 0.0, noUpfront.CommitmentUSD)
+	noUpfront.CommitmentUSD = 1
+	report = CheckQuality(&QualityInput{PreviousList: previousList, CurrentList: changedList}, ruleList)
+	require.Len(t, report.IssueList, 1)
+	require.Equal(t, "price-change", report.IssueList[0].Rule)
+	require.Equal(t, "AAA.d", report.IssueList[0].TermCode)
+	require.Equal(t, "the price changed by hourly - and commitment from $0 to $1, exceeding ±50%", report.IssueList[0].Message)
+
+	// the rules comparing snapshots are skipped without the previous snapshot.
+	report = CheckQuality(&QualityInput{CurrentList: currentList}, ruleList)
+	require.Len(t, report.IssueList, 2)
+
+	// an instance without any active region.
+	currentList[0].RegionList = nil
+	report = CheckQuality(&QualityInput{CurrentList: currentList}, ruleList)
+	require.Len(t, report.IssueList, 1)
+	require.Equal(t, "region", report.IssueList[0].Rule)
+}
@@ -0,0 +1,173 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/region"
+	"github.com/bytebase/dbcost/taxonomy"
+)
+
+// SchemaVersion is the version of the dataset schema, it should be bumped on any breaking change of the output.
+// Version 2 adds the architecture and the equivalents of the instances, and the price-per-unit metrics of the terms.
+const SchemaVersion = 2
+
+// jsonSchema is a JSON Schema document or a subschema of it.
+type jsonSchema map[string]interface{}
+
+// schemaEnumMap is the allowed values of the enum types, empty values are allowed for the fields left empty if unknown.
+var schemaEnumMap = map[reflect.Type][]string{
+	reflect.TypeOf(client.EngineType("")): {client.EngineTypeMySQL, client.EngineTypePostgreSQL},
+	reflect.TypeOf(client.ChargeType("")): {string(client.ChargeTypeOnDemand), string(client.ChargeTypeReserved)},
+	reflect.TypeOf(RowStatus("")):         {string(RowStatusNormal), string(RowStatusArchived)},
+	reflect.TypeOf(region.Continent("")): {"",
+		string(region.ContinentAfrica), string(region.ContinentAsia), string(region.ContinentEurope),
+		string(region.ContinentNorthAmerica), string(region.ContinentSouthAmerica), string(region.ContinentOceania),
+	},
+	reflect.TypeOf(taxonomy.Family("")): {"",
+		string(taxonomy.FamilyGeneralPurpose), string(taxonomy.FamilyMemoryOptimized),
+		string(taxonomy.FamilyBurstable), string(taxonomy.FamilyComputeOptimized),
+	},
+	reflect.TypeOf(taxonomy.Architecture("")): {"", string(taxonomy.ArchitectureX86), string(taxonomy.ArchitectureARM)},
+}
+
+// schemaFieldMap is the constraints of the fields that are not implied by their Go types, keyed by Type.Field.
+var schemaFieldMap = map[string]jsonSchema{
+	"Dataset.SchemaVersion":      {"const": SchemaVersion},
+	"Source.CloudProvider":       {"enum": []string{CloudProviderAWS, CloudProviderGCP, CloudProviderALIYUN}},
+	"DBInstance.CloudProvider":   {"enum": []string{CloudProviderAWS, CloudProviderGCP, CloudProviderALIYUN}},
+	"DBInstance.ExternalID":      {"pattern": "^(AWS|GCP|ALIYUN):.+$"},
+	"DBInstance.Name":            {"minLength": 1},
+	"DBInstance.CPU":             {"minimum": 0},
+	"DBInstance.RegionList":      {"type": "array", "minItems": 1},
+	"Region.Code":                {"minLength": 1},
+	"Region.TermList":            {"type": "array", "minItems": 1},
+	"Equivalent.ExternalID":      {"pattern": "^(AWS|GCP|ALIYUN):.+$"},
+	"Equivalent.Score":           {"minimum": 0, "maximum": 1},
+	"Term.Code":                  {"minLength": 1},
+	"Term.HourlyUSD":             {"minimum": 0},
+	"Term.CommitmentUSD":         {"minimum": 0},
+	"Term.USDPerCPUHour":         {"minimum": 0},
+	"Term.USDPerGiBHour":         {"minimum": 0},
+	"Term.PricePerformanceIndex": {"minimum": 0},
+}
+
+// schemaStructMap is the constraints across the fields of a struct, keyed by Type.
+var schemaStructMap = map[string]jsonSchema{
+	// a reserved term is priced by its lease contract length, e.g. cost.Calculate amortizes the commitment over it.
+	"Term": {
+		"if": jsonSchema{
+			"properties": jsonSchema{"type": jsonSchema{"const": client.ChargeTypeReserved}},
+			"required":   []string{"type"},
+		},
+		"then": jsonSchema{
+			"properties": jsonSchema{"payload": jsonSchema{
+				"type":       "object",
+				"properties": jsonSchema{"leaseContractLength": jsonSchema{"minLength": 1}},
+				"required":   []string{"leaseContractLength"},
+			}},
+			"required": []string{"payload"},
+		},
+	},
+}
+
+// GenerateSchema generates the JSON Schema of the dataset saved by Save from the store types.
+func GenerateSchema() map[string]interface{} {
+	defs := make(map[string]interface{})
+	root := jsonSchema{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"title":       "dbcost dataset",
+		"description": fmt.Sprintf("The pricing data of the cloud databases, schema version %d.", SchemaVersion),
+	}
+	for key, value := range getSchema(reflect.TypeOf(Dataset{}), defs) {
+		root[key] = value
+	}
+	root["$defs"] = defs
+	return root
+}
+
+// MarshalSchema returns the indented JSON Schema of the dataset.
+func MarshalSchema() ([]byte, error) {
+	dataByted, err := json.MarshalIndent(GenerateSchema(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(dataByted, '\n'), nil
+}
+
+// getSchema returns the schema of the type, structs are defined in defs and referenced.
+func getSchema(t reflect.Type, defs map[string]interface{}) jsonSchema {
+	schema := jsonSchema{}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return jsonSchema{"anyOf": []interface{}{getSchema(t.Elem(), defs), jsonSchema{"type": "null"}}}
+	case reflect.Slice:
+		// nil slices are marshaled to null.
+		schema["type"] = []string{"array", "null"}
+		schema["items"] = getSchema(t.Elem(), defs)
+	case reflect.Struct:
+		if _, ok := defs[t.Name()]; !ok {
+			// the placeholder stops the recursion of self-referenced types.
+			defs[t.Name()] = jsonSchema{}
+			defs[t.Name()] = getStructSchema(t, defs)
+		}
+		schema["$ref"] = "#/$defs/" + t.Name()
+	case reflect.String:
+		schema["type"] = "string"
+		if enum, ok := schemaEnumMap[t]; ok {
+			schema["enum"] = enum
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	default:
+		panic(fmt.Sprintf("unsupported type %s in the dataset schema", t))
+	}
+	return schema
+}
+
+func getStructSchema(t reflect.Type, defs map[string]interface{}) jsonSchema {
+	properties := make(map[string]interface{})
+	var requiredList []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, option, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if option != "omitempty" {
+			requiredList = append(requiredList, name)
+		}
+
+		schema := getSchema(field.Type, defs)
+		for key, value := range schemaFieldMap[t.Name()+"."+field.Name] {
+			schema[key] = value
+		}
+		properties[name] = schema
+	}
+	sort.Strings(requiredList)
+	schema := jsonSchema{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             requiredList,
+		"additionalProperties": false,
+	}
+	for key, value := range schemaStructMap[t.Name()] {
+		schema[key] = value
+	}
+	return schema
+}
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SchemaPublished(t *testing.T) {
+	// the published schema should be regenerated by go run ./seed schema once the store types change.
+	published, err := os.ReadFile(fmt.Sprintf("../schema/dataset.v%d.json", SchemaVersion))
+	require.NoError(t, err)
+	generated, err := MarshalSchema()
+	require.NoError(t, err)
+	require.Equal(t, string(generated), string(published))
+}
+
+func Test_ValidateDataset(t *testing.T) {
+	dataByted, err := os.ReadFile("../data/sample.json")
+	require.NoError(t, err)
+	errorList, err := ValidateDataset(dataByted)
+	require.NoError(t, err)
+	require.Empty(t, errorList)
+
+	dataset, err := LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+	dataset.SchemaVersion = 0
+	dataset.DBInstanceList[0].RegionList[0].TermList[0].HourlyUSD = -1
+	dataset.DBInstanceList[0].RegionList[0].TermList[1].Type = "Spot"
+	// a reserved term should have the lease contract length.
+	dataset.DBInstanceList[0].RegionList[0].TermList[2].Payload = nil
+	dataset.DBInstanceList[0].RegionList[0].TermList[3].Payload = &TermPayload{PurchaseOption: "No Upfront"}
+	dataset.DBInstanceList[1].RegionList = nil
+	dataset.DBInstanceList = append(dataset.DBInstanceList, dataset.DBInstanceList[0])
+	dataByted, err = json.Marshal(dataset)
+	require.NoError(t, err)
+
+	errorList, err = ValidateDataset(dataByted)
+	require.NoError(t, err)
+	var messageList []string
+	for _, validationError := range errorList {
+		messageList = append(messageList, validationError.Error())
+	}
+	require.Equal(t, []string{
+		"$.dbInstanceList[0].regionList[0].termList[0].hourlyUSD: -1 should be no less than 0",
+		"$.dbInstanceList[0].regionList[0].termList[1].type: Spot is not one of [OnDemand Reserved]",
+		"$.dbInstanceList[0].regionList[0].termList[2].payload: should be of type object, got null",
+		"$.dbInstanceList[0].regionList[0].termList[3].payload.leaseContractLength: should not be empty",
+		"$.dbInstanceList[1].regionList: should be of type array, got null",
+		"$.dbInstanceList[2].regionList[0].termList[0].hourlyUSD: -1 should be no less than 0",
+		"$.dbInstanceList[2].regionList[0].termList[1].type: Spot is not one of [OnDemand Reserved]",
+		"$.dbInstanceList[2].regionList[0].termList[2].payload: should be of type object, got null",
+		"$.dbInstanceList[2].regionList[0].termList[3].payload.leaseContractLength: should not be empty",
+		"$.schemaVersion: should be 2",
+	}, messageList)
+
+	dataset, err = LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+	dataset.DBInstanceList = append(dataset.DBInstanceList, dataset.DBInstanceList[0])
+	dataByted, err = json.Marshal(dataset)
+	require.NoError(t, err)
+	errorList, err = ValidateDataset(dataByted)
+	require.NoError(t, err)
+	require.Len(t, errorList, 1)
+	require.Equal(t, "$.dbInstanceList[2].externalId: AWS:db.r6g.4xlarge is duplicated with $.dbInstanceList[0]", errorList[0].Error())
+
+	errorList, err = ValidateDataset([]byte(`{"schemaVersion": 2, "metadata": null, "dbInstanceList": [], "extra": true}`))
+	require.NoError(t, err)
+	require.Len(t, errorList, 1)
+	require.Equal(t, `$: unknown property "extra"`, errorList[0].Error())
+
+	_, err = ValidateDataset([]byte(`{`))
+	require.Error(t, err)
+}
+
+func Test_ValidateDatasetWithPublishedSchema(t *testing.T) {
+	// the data published before the schema bump conforms to the schema of the version it declares.
+	dataByted, err := os.ReadFile("testdata/sample.v1.json")
+	require.NoError(t, err)
+	schemaVersion, err := GetSchemaVersion(dataByted)
+	require.NoError(t, err)
+	require.Equal(t, 1, schemaVersion)
+	schemaByted, err := os.ReadFile(fmt.Sprintf("../schema/dataset.v%d.json", schemaVersion))
+	require.NoError(t, err)
+	errorList, err := ValidateDatasetWithSchema(dataByted, schemaByted)
+	require.NoError(t, err)
+	require.Empty(t, errorList)
+
+	// but not to the current one.
+	errorList, err = ValidateDataset(dataByted)
+	require.NoError(t, err)
+	require.NotEmpty(t, errorList)
+}
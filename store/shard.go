@@ -0,0 +1,247 @@
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ShardBy is the dimension the dataset is sharded by.
+type ShardBy string
+
+const (
+	// ShardByProvider shards the dataset into a file per provider, e.g. provider/aws.json.
+	ShardByProvider ShardBy = "provider"
+	// ShardByRegion shards the dataset into a file per canonical region, e.g. region/europe-frankfurt.json.
+	// Only the regions of the slug are kept in the instances of the shard.
+	ShardByRegion ShardBy = "region"
+	// ShardByInstance shards the dataset into a file per instance, e.g. instance/aws/db.m5.large.json.
+	ShardByInstance ShardBy = "instance"
+)
+
+// manifestFileName is the file name of the manifest in the shard directory.
+const manifestFileName = "index.json"
+
+// ParseShardByList parses the comma separated shard dimension list, e.g. provider,region.
+func ParseShardByList(s string) ([]ShardBy, error) {
+	var shardByList []ShardBy
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		switch shardBy := ShardBy(item); shardBy {
+		case ShardByProvider, ShardByRegion, ShardByInstance:
+			shardByList = append(shardByList, shardBy)
+		default:
+			return nil, fmt.Errorf("unknown shard dimension %q, allowed dimensions are provider, region and instance", item)
+		}
+	}
+	return shardByList, nil
+}
+
+// Shard is a file of the sharded dataset, which is a dataset with a nil Metadata.
+type Shard struct {
+	ShardBy ShardBy `json:"shardBy"`
+	// Key is the provider, the region slug or the external ID of the shard.
+	Key string `json:"key"`
+	// Path is the path of the shard relative to the manifest.
+	Path          string `json:"path"`
+	InstanceCount int    `json:"instanceCount"`
+	// Size and SHA256 are of the uncompressed shard.
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest is the index of the sharded dataset, so that the readers only need to load the shards they need.
+type Manifest struct {
+	SchemaVersion int       `json:"schemaVersion"`
+	Metadata      *Metadata `json:"metadata"`
+	// CompressionList is the compressed variants available for each shard, e.g. region/europe-frankfurt.json.gz.
+	CompressionList []Compression `json:"compressionList"`
+	ShardList       []*Shard      `json:"shardList"`
+}
+
+// SaveShard saves the dataset sharded by each dimension in shardByList to dirPath, with the manifest saved as index.json.
+// The manifest is saved after all the shards, and the shards no longer referenced are removed at last,
+// so that readers following the manifest never see a missing shard.
+func SaveShard(dataset *Dataset, dirPath string, shardByList []ShardBy, compressionList ...Compression) (*Manifest, error) {
+	manifest := &Manifest{
+		SchemaVersion:   SchemaVersion,
+		Metadata:        dataset.Metadata,
+		CompressionList: compressionList,
+	}
+	for _, shardBy := range shardByList {
+		for _, group := range groupDBInstance(dataset.DBInstanceList, shardBy) {
+			shard, err := saveShard(group, dirPath, shardBy, compressionList)
+			if err != nil {
+				return nil, err
+			}
+			manifest.ShardList = append(manifest.ShardList, shard)
+		}
+	}
+
+	dataByted, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(path.Join(dirPath, manifestFileName), dataByted, compressionList); err != nil {
+		return nil, err
+	}
+	if err := removeStaleShard(dirPath, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// LoadManifest loads the manifest saved by SaveShard in dirPath.
+func LoadManifest(dirPath string) (*Manifest, error) {
+	dataByted, err := os.ReadFile(path.Join(dirPath, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(dataByted, manifest); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the manifest in %s, [internal]: %v", dirPath, err)
+	}
+	return manifest, nil
+}
+
+// shardGroup is the instances of a shard.
+type shardGroup struct {
+	key            string
+	path           string
+	dbInstanceList []*DBInstance
+}
+
+// groupDBInstance groups the instances by the shard dimension, the groups are ordered by the key.
+func groupDBInstance(dbInstanceList []*DBInstance, shardBy ShardBy) []*shardGroup {
+	groupMap := make(map[string]*shardGroup)
+	addToGroup := func(key, filePath string, dbInstance *DBInstance) {
+		group, ok := groupMap[key]
+		if !ok {
+			group = &shardGroup{key: key, path: filePath}
+			groupMap[key] = group
+		}
+		group.dbInstanceList = append(group.dbInstanceList, dbInstance)
+	}
+
+	for _, dbInstance := range dbInstanceList {
+		switch shardBy {
+		case ShardByProvider:
+			addToGroup(dbInstance.CloudProvider, path.Join(string(shardBy), strings.ToLower(dbInstance.CloudProvider)+".json"), dbInstance)
+		case ShardByInstance:
+			filePath := path.Join(string(shardBy), strings.ToLower(dbInstance.CloudProvider), getShardFileName(dbInstance.Name)+".json")
+			addToGroup(dbInstance.GetExternalID(), filePath, dbInstance)
+		case ShardByRegion:
+			// an instance is split into the shards of its regions, with only the regions of the shard kept.
+			regionMap := make(map[string][]*Region)
+			for _, r := range dbInstance.RegionList {
+				slug := getRegionShardKey(r)
+				regionMap[slug] = append(regionMap[slug], r)
+			}
+			for _, slug := range getSortedKey(regionMap) {
+				sharded := *dbInstance
+				sharded.RegionList = regionMap[slug]
+				addToGroup(slug, path.Join(string(shardBy), getShardFileName(slug)+".json"), &sharded)
+			}
+		}
+	}
+
+	var groupList []*shardGroup
+	for _, key := range getSortedKey(groupMap) {
+		groupList = append(groupList, groupMap[key])
+	}
+	return groupList
+}
+
+func saveShard(group *shardGroup, dirPath string, shardBy ShardBy, compressionList []Compression) (*Shard, error) {
+	dataByted, err := json.MarshalIndent(&Dataset{
+		SchemaVersion:  SchemaVersion,
+		DBInstanceList: group.dbInstanceList,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	filePath := path.Join(dirPath, group.path)
+	if err := os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := writeFile(filePath, dataByted, compressionList); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(dataByted)
+	return &Shard{
+		ShardBy:       shardBy,
+		Key:           group.key,
+		Path:          group.path,
+		InstanceCount: len(group.dbInstanceList),
+		Size:          len(dataByted),
+		SHA256:        hex.EncodeToString(checksum[:]),
+	}, nil
+}
+
+// removeStaleShard removes the shard files, including the compressed variants, not referenced by the manifest.
+func removeStaleShard(dirPath string, manifest *Manifest) error {
+	referencedMap := make(map[string]bool)
+	for _, shard := range manifest.ShardList {
+		referencedMap[shard.Path] = true
+		for _, compression := range manifest.CompressionList {
+			referencedMap[shard.Path+compression.Extension()] = true
+		}
+	}
+
+	var staleList []string
+	for _, shardBy := range []ShardBy{ShardByProvider, ShardByRegion, ShardByInstance} {
+		shardDirPath := path.Join(dirPath, string(shardBy))
+		err := filepath.WalkDir(shardDirPath, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			relativePath, err := filepath.Rel(dirPath, filePath)
+			if err != nil {
+				return err
+			}
+			if !referencedMap[filepath.ToSlash(relativePath)] {
+				staleList = append(staleList, filePath)
+			}
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	sort.Strings(staleList)
+	for _, filePath := range staleList {
+		if err := os.Remove(filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRegionShardKey returns the slug of the region, the lower-cased code is used if the region is not in the catalog.
+func getRegionShardKey(r *Region) string {
+	if r.Slug != "" {
+		return r.Slug
+	}
+	return strings.ToLower(r.Code)
+}
+
+// getShardFileName replaces the characters not safe in file names, e.g. the spaces in AWS location strings.
+func getShardFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, name)
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SaveShard(t *testing.T) {
+	dataset, err := LoadDataset("../data/sample.json")
+	require.NoError(t, err)
+
+	dirPath := t.TempDir()
+	shardByList, err := ParseShardByList("provider,region,instance")
+	require.NoError(t, err)
+	manifest, err := SaveShard(dataset, dirPath, shardByList, CompressionGzip)
+	require.NoError(t, err)
+
+	var pathList []string
+	for _, shard := range manifest.ShardList {
+		pathList = append(pathList, shard.Path)
+	}
+	require.Equal(t, []string{
+		"provider/aws.json",
+		"provider/gcp.json",
+		"region/asia-pacific-mumbai.json",
+		"region/us-east-n-virginia.json",
+		"instance/aws/db.r6g.4xlarge.json",
+		"instance/gcp/db-N1Standard-96-360.json",
+	}, pathList)
+
+	loaded, err := LoadManifest(dirPath)
+	require.NoError(t, err)
+	require.Equal(t, manifest, loaded)
+
+	// the region shard only keeps the regions of the slug, across providers.
+	regionShard, err := LoadDataset(path.Join(dirPath, "region/us-east-n-virginia.json.gz"))
+	require.NoError(t, err)
+	require.Nil(t, regionShard.Metadata)
+	require.Len(t, regionShard.DBInstanceList, 2)
+	require.Equal(t, "us-east-1", regionShard.DBInstanceList[0].RegionList[0].Code)
+	require.Len(t, regionShard.DBInstanceList[0].RegionList, 1)
+	require.Equal(t, "us-east4", regionShard.DBInstanceList[1].RegionList[0].Code)
+	require.Len(t, dataset.DBInstanceList[0].RegionList, 2)
+
+	// the shards no longer referenced are removed.
+	dataset.DBInstanceList = dataset.DBInstanceList[:1]
+	manifest, err = SaveShard(dataset, dirPath, []ShardBy{ShardByInstance})
+	require.NoError(t, err)
+	require.Len(t, manifest.ShardList, 1)
+	_, err = os.Stat(path.Join(dirPath, "instance/gcp/db-N1Standard-96-360.json"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(dirPath, "provider/aws.json.gz"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(dirPath, "instance/aws/db.r6g.4xlarge.json"))
+	require.NoError(t, err)
+}
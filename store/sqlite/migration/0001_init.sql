@@ -0,0 +1,87 @@
+-- snapshot is a dataset imported at a given time, the prices of each term are recorded per snapshot.
+CREATE TABLE snapshot (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_ts INTEGER NOT NULL,
+    source TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE db_instance (
+    id INTEGER PRIMARY KEY,
+    external_id TEXT NOT NULL UNIQUE,
+    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL DEFAULT 0,
+    updater_id INTEGER NOT NULL DEFAULT 0,
+    cloud_provider TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cpu INTEGER NOT NULL,
+    memory TEXT NOT NULL,
+    processor TEXT NOT NULL DEFAULT '',
+    family TEXT NOT NULL DEFAULT '',
+    series TEXT NOT NULL DEFAULT '',
+    size TEXT NOT NULL DEFAULT '',
+    size_rank INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_db_instance_cloud_provider ON db_instance(cloud_provider);
+
+CREATE TABLE region (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    db_instance_id INTEGER NOT NULL REFERENCES db_instance(id),
+    code TEXT NOT NULL,
+    slug TEXT NOT NULL DEFAULT '',
+    name TEXT NOT NULL DEFAULT '',
+    geography TEXT NOT NULL DEFAULT '',
+    continent TEXT NOT NULL DEFAULT '',
+    latitude REAL NOT NULL DEFAULT 0,
+    longitude REAL NOT NULL DEFAULT 0,
+    UNIQUE (db_instance_id, code)
+);
+
+CREATE INDEX idx_region_slug ON region(slug);
+
+CREATE TABLE term (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    region_id INTEGER NOT NULL REFERENCES region(id),
+    code TEXT NOT NULL,
+    database_engine TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('OnDemand', 'Reserved')),
+    -- lease_contract_length and purchase_option are empty for the OnDemand terms.
+    lease_contract_length TEXT NOT NULL DEFAULT '',
+    purchase_option TEXT NOT NULL DEFAULT '',
+    UNIQUE (region_id, code)
+);
+
+CREATE TABLE term_price (
+    snapshot_id INTEGER NOT NULL REFERENCES snapshot(id),
+    term_id INTEGER NOT NULL REFERENCES term(id),
+    hourly_usd REAL NOT NULL,
+    commitment_usd REAL NOT NULL,
+    PRIMARY KEY (snapshot_id, term_id)
+);
+
+CREATE INDEX idx_term_price_term_id ON term_price(term_id);
+
+-- latest_price is the flattened prices of the latest snapshot, which is handy for ad-hoc queries.
+CREATE VIEW latest_price AS
+SELECT
+    db_instance.external_id,
+    db_instance.cloud_provider,
+    db_instance.name,
+    db_instance.cpu,
+    db_instance.memory,
+    db_instance.processor,
+    db_instance.family,
+    region.code AS region_code,
+    region.slug AS region_slug,
+    term.code AS term_code,
+    term.database_engine,
+    term.type,
+    term.lease_contract_length,
+    term.purchase_option,
+    term_price.hourly_usd,
+    term_price.commitment_usd
+FROM term_price
+    JOIN term ON term.id = term_price.term_id
+    JOIN region ON region.id = term.region_id
+    JOIN db_instance ON db_instance.id = region.db_instance_id
+WHERE term_price.snapshot_id = (SELECT MAX(id) FROM snapshot);
@@ -0,0 +1,35 @@
+-- lifecycle fields maintained by store.Merge, archived rows are no longer provided upstream.
+ALTER TABLE db_instance ADD COLUMN updated_ts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE db_instance ADD COLUMN archived_ts INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE term ADD COLUMN row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL';
+ALTER TABLE term ADD COLUMN updated_ts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE term ADD COLUMN archived_ts INTEGER NOT NULL DEFAULT 0;
+
+-- latest_price only includes the terms still provided upstream.
+DROP VIEW latest_price;
+CREATE VIEW latest_price AS
+SELECT
+    db_instance.external_id,
+    db_instance.cloud_provider,
+    db_instance.name,
+    db_instance.cpu,
+    db_instance.memory,
+    db_instance.processor,
+    db_instance.family,
+    region.code AS region_code,
+    region.slug AS region_slug,
+    term.code AS term_code,
+    term.database_engine,
+    term.type,
+    term.lease_contract_length,
+    term.purchase_option,
+    term_price.hourly_usd,
+    term_price.commitment_usd
+FROM term_price
+    JOIN term ON term.id = term_price.term_id
+    JOIN region ON region.id = term.region_id
+    JOIN db_instance ON db_instance.id = region.db_instance_id
+WHERE term_price.snapshot_id = (SELECT MAX(id) FROM snapshot)
+    AND db_instance.row_status = 'NORMAL'
+    AND term.row_status = 'NORMAL';
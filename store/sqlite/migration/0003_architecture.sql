@@ -0,0 +1,2 @@
+-- the architecture is classified along with the family, the equivalents are derived from it on load.
+ALTER TABLE db_instance ADD COLUMN architecture TEXT NOT NULL DEFAULT '';
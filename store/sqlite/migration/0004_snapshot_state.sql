@@ -0,0 +1,177 @@
+-- The instances, the regions and the terms are split into the identity shared by all the snapshots,
+-- and the state recorded per snapshot, so an older snapshot loads the specs and the lifecycle it was imported with.
+DROP VIEW latest_price;
+DROP INDEX idx_db_instance_cloud_provider;
+DROP INDEX idx_region_slug;
+DROP INDEX idx_term_price_term_id;
+
+ALTER TABLE db_instance RENAME TO legacy_db_instance;
+ALTER TABLE region RENAME TO legacy_region;
+ALTER TABLE term RENAME TO legacy_term;
+ALTER TABLE term_price RENAME TO legacy_term_price;
+
+CREATE TABLE db_instance (
+    id INTEGER PRIMARY KEY,
+    external_id TEXT NOT NULL UNIQUE,
+    cloud_provider TEXT NOT NULL,
+    name TEXT NOT NULL
+);
+
+CREATE INDEX idx_db_instance_cloud_provider ON db_instance(cloud_provider);
+
+-- db_instance_snapshot is the spec and the lifecycle of the instance in a snapshot.
+CREATE TABLE db_instance_snapshot (
+    snapshot_id INTEGER NOT NULL REFERENCES snapshot(id),
+    db_instance_id INTEGER NOT NULL REFERENCES db_instance(id),
+    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL DEFAULT 0,
+    updater_id INTEGER NOT NULL DEFAULT 0,
+    updated_ts INTEGER NOT NULL DEFAULT 0,
+    archived_ts INTEGER NOT NULL DEFAULT 0,
+    cpu INTEGER NOT NULL,
+    memory TEXT NOT NULL,
+    processor TEXT NOT NULL DEFAULT '',
+    family TEXT NOT NULL DEFAULT '',
+    series TEXT NOT NULL DEFAULT '',
+    size TEXT NOT NULL DEFAULT '',
+    size_rank INTEGER NOT NULL DEFAULT 0,
+    architecture TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (snapshot_id, db_instance_id)
+);
+
+-- region is the region of a provider, e.g. us-east-1 of AWS, shared by all the instances provided in it.
+CREATE TABLE region (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    cloud_provider TEXT NOT NULL,
+    code TEXT NOT NULL,
+    UNIQUE (cloud_provider, code)
+);
+
+-- region_snapshot is the canonical metadata of the region in a snapshot.
+CREATE TABLE region_snapshot (
+    snapshot_id INTEGER NOT NULL REFERENCES snapshot(id),
+    region_id INTEGER NOT NULL REFERENCES region(id),
+    slug TEXT NOT NULL DEFAULT '',
+    name TEXT NOT NULL DEFAULT '',
+    geography TEXT NOT NULL DEFAULT '',
+    continent TEXT NOT NULL DEFAULT '',
+    latitude REAL NOT NULL DEFAULT 0,
+    longitude REAL NOT NULL DEFAULT 0,
+    PRIMARY KEY (snapshot_id, region_id)
+);
+
+CREATE INDEX idx_region_snapshot_slug ON region_snapshot(slug);
+
+-- db_instance_region is an instance provided in a region, the terms belong to it.
+CREATE TABLE db_instance_region (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    db_instance_id INTEGER NOT NULL REFERENCES db_instance(id),
+    region_id INTEGER NOT NULL REFERENCES region(id),
+    UNIQUE (db_instance_id, region_id)
+);
+
+CREATE TABLE term (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    db_instance_region_id INTEGER NOT NULL REFERENCES db_instance_region(id),
+    code TEXT NOT NULL,
+    UNIQUE (db_instance_region_id, code)
+);
+
+-- term_snapshot is the state and the price of the term in a snapshot.
+CREATE TABLE term_snapshot (
+    snapshot_id INTEGER NOT NULL REFERENCES snapshot(id),
+    term_id INTEGER NOT NULL REFERENCES term(id),
+    row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+    updated_ts INTEGER NOT NULL DEFAULT 0,
+    archived_ts INTEGER NOT NULL DEFAULT 0,
+    database_engine TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('OnDemand', 'Reserved')),
+    -- lease_contract_length and purchase_option are empty for the OnDemand terms.
+    lease_contract_length TEXT NOT NULL DEFAULT '',
+    purchase_option TEXT NOT NULL DEFAULT '',
+    hourly_usd REAL NOT NULL,
+    commitment_usd REAL NOT NULL,
+    PRIMARY KEY (snapshot_id, term_id)
+);
+
+CREATE INDEX idx_term_snapshot_term_id ON term_snapshot(term_id);
+
+-- the legacy rows only kept the state of the latest import, which is assumed for all the snapshots they are priced in.
+INSERT INTO db_instance (id, external_id, cloud_provider, name)
+SELECT id, external_id, cloud_provider, name FROM legacy_db_instance;
+
+INSERT INTO db_instance_snapshot (snapshot_id, db_instance_id, row_status, creator_id, updater_id, updated_ts, archived_ts, cpu, memory, processor, family, series, size, size_rank, architecture)
+SELECT DISTINCT
+    legacy_term_price.snapshot_id, legacy_db_instance.id, legacy_db_instance.row_status, legacy_db_instance.creator_id, legacy_db_instance.updater_id,
+    legacy_db_instance.updated_ts, legacy_db_instance.archived_ts, legacy_db_instance.cpu, legacy_db_instance.memory, legacy_db_instance.processor,
+    legacy_db_instance.family, legacy_db_instance.series, legacy_db_instance.size, legacy_db_instance.size_rank, legacy_db_instance.architecture
+FROM legacy_term_price
+    JOIN legacy_term ON legacy_term.id = legacy_term_price.term_id
+    JOIN legacy_region ON legacy_region.id = legacy_term.region_id
+    JOIN legacy_db_instance ON legacy_db_instance.id = legacy_region.db_instance_id;
+
+INSERT INTO region (cloud_provider, code)
+SELECT DISTINCT legacy_db_instance.cloud_provider, legacy_region.code
+FROM legacy_region
+    JOIN legacy_db_instance ON legacy_db_instance.id = legacy_region.db_instance_id;
+
+INSERT OR IGNORE INTO region_snapshot (snapshot_id, region_id, slug, name, geography, continent, latitude, longitude)
+SELECT
+    legacy_term_price.snapshot_id, region.id, legacy_region.slug, legacy_region.name, legacy_region.geography,
+    legacy_region.continent, legacy_region.latitude, legacy_region.longitude
+FROM legacy_term_price
+    JOIN legacy_term ON legacy_term.id = legacy_term_price.term_id
+    JOIN legacy_region ON legacy_region.id = legacy_term.region_id
+    JOIN legacy_db_instance ON legacy_db_instance.id = legacy_region.db_instance_id
+    JOIN region ON region.cloud_provider = legacy_db_instance.cloud_provider AND region.code = legacy_region.code;
+
+INSERT INTO db_instance_region (id, db_instance_id, region_id)
+SELECT legacy_region.id, legacy_region.db_instance_id, region.id
+FROM legacy_region
+    JOIN legacy_db_instance ON legacy_db_instance.id = legacy_region.db_instance_id
+    JOIN region ON region.cloud_provider = legacy_db_instance.cloud_provider AND region.code = legacy_region.code;
+
+INSERT INTO term (id, db_instance_region_id, code)
+SELECT id, region_id, code FROM legacy_term;
+
+INSERT INTO term_snapshot (snapshot_id, term_id, row_status, updated_ts, archived_ts, database_engine, type, lease_contract_length, purchase_option, hourly_usd, commitment_usd)
+SELECT
+    legacy_term_price.snapshot_id, legacy_term.id, legacy_term.row_status, legacy_term.updated_ts, legacy_term.archived_ts, legacy_term.database_engine,
+    legacy_term.type, legacy_term.lease_contract_length, legacy_term.purchase_option, legacy_term_price.hourly_usd, legacy_term_price.commitment_usd
+FROM legacy_term_price
+    JOIN legacy_term ON legacy_term.id = legacy_term_price.term_id;
+
+DROP TABLE legacy_term_price;
+DROP TABLE legacy_term;
+DROP TABLE legacy_region;
+DROP TABLE legacy_db_instance;
+
+-- latest_price only includes the terms still provided upstream.
+CREATE VIEW latest_price AS
+SELECT
+    db_instance.external_id,
+    db_instance.cloud_provider,
+    db_instance.name,
+    db_instance_snapshot.cpu,
+    db_instance_snapshot.memory,
+    db_instance_snapshot.processor,
+    db_instance_snapshot.family,
+    region.code AS region_code,
+    region_snapshot.slug AS region_slug,
+    term.code AS term_code,
+    term_snapshot.database_engine,
+    term_snapshot.type,
+    term_snapshot.lease_contract_length,
+    term_snapshot.purchase_option,
+    term_snapshot.hourly_usd,
+    term_snapshot.commitment_usd
+FROM term_snapshot
+    JOIN term ON term.id = term_snapshot.term_id
+    JOIN db_instance_region ON db_instance_region.id = term.db_instance_region_id
+    JOIN db_instance ON db_instance.id = db_instance_region.db_instance_id
+    JOIN region ON region.id = db_instance_region.region_id
+    JOIN db_instance_snapshot ON db_instance_snapshot.snapshot_id = term_snapshot.snapshot_id AND db_instance_snapshot.db_instance_id = db_instance.id
+    JOIN region_snapshot ON region_snapshot.snapshot_id = term_snapshot.snapshot_id AND region_snapshot.region_id = region.id
+WHERE term_snapshot.snapshot_id = (SELECT MAX(id) FROM snapshot)
+    AND db_instance_snapshot.row_status = 'NORMAL'
+    AND term_snapshot.row_status = 'NORMAL';
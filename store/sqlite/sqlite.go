@@ -0,0 +1,385 @@
+// Package sqlite is the SQLite backed store of the pricing data.
+// Instances, the regions of the providers and the terms are normalized into tables of their identities,
+// and their states and prices are recorded per snapshot, so an older snapshot is loaded as it was imported.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bytebase/dbcost/client"
+	"github.com/bytebase/dbcost/store"
+
+	// register the pure-Go SQLite driver.
+	_ "modernc.org/sqlite"
+)
+
+//go:embed migration/*.sql
+var migrationFS embed.FS
+
+// Store is the SQLite backed store.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens the SQLite database at filePath and migrates it to the latest version.
+func Open(ctx context.Context, filePath string) (*Store, error) {
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=foreign_keys(1)", filePath))
+	if err != nil {
+		return nil, fmt.Errorf("Fail to open the database %s, [internal]: %v", filePath, err)
+	}
+	// SQLite only allows a single writer.
+	db.SetMaxOpenConns(1)
+
+	s := &Store{db: db}
+	if err := s.Migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DB returns the underlying database for ad-hoc queries.
+func (s *Store) DB() *sql.DB {
+	return s.db
+}
+
+// migration is a versioned migration, the file name is in the form of ${VERSION}_${DESCRIPTION}.sql, e.g. 0001_init.sql.
+type migration struct {
+	version  int
+	fileName string
+}
+
+// Migrate applies the pending migrations in order, each migration is applied in its own transaction.
+func (s *Store) Migrate(ctx context.Context) error {
+	return s.migrate(ctx, math.MaxInt)
+}
+
+// migrate applies the pending migrations up to the target version.
+func (s *Store) migrate(ctx context.Context, targetVersion int) error {
+	if _, err := s.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS migration_history (
+		version INTEGER PRIMARY KEY,
+		file_name TEXT NOT NULL,
+		applied_ts INTEGER NOT NULL DEFAULT (strftime('%s', 'now'))
+	)`); err != nil {
+		return fmt.Errorf("Fail to create the migration history, [internal]: %v", err)
+	}
+
+	var currentVersion int
+	if err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM migration_history`).Scan(&currentVersion); err != nil {
+		return fmt.Errorf("Fail to get the current version, [internal]: %v", err)
+	}
+
+	migrationList, err := getMigrationList()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrationList {
+		if m.version <= currentVersion || m.version > targetVersion {
+			continue
+		}
+		if err := s.applyMigration(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Version returns the version of the latest applied migration.
+func (s *Store) Version(ctx context.Context) (int, error) {
+	var version int
+	if err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM migration_history`).Scan(&version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (s *Store) applyMigration(ctx context.Context, m *migration) error {
+	statement, err := migrationFS.ReadFile("migration/" + m.fileName)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, string(statement)); err != nil {
+		return fmt.Errorf("Fail to apply the migration %s, [internal]: %v", m.fileName, err)
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO migration_history (version, file_name) VALUES (?, ?)`, m.version, m.fileName); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func getMigrationList() ([]*migration, error) {
+	entryList, err := fs.ReadDir(migrationFS, "migration")
+	if err != nil {
+		return nil, err
+	}
+	var migrationList []*migration
+	for _, entry := range entryList {
+		versionStr, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("Invalid migration file name %s", entry.Name())
+		}
+		version, err := strconv.Atoi(versionStr)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid migration version of %s, [internal]: %v", entry.Name(), err)
+		}
+		migrationList = append(migrationList, &migration{version: version, fileName: entry.Name()})
+	}
+	sort.Slice(migrationList, func(i, j int) bool { return migrationList[i].version < migrationList[j].version })
+	return migrationList, nil
+}
+
+// Import imports the dbInstanceList as a new snapshot created at ts, the ID of the snapshot is returned.
+// The identities of the instances, regions and terms are upserted, and their states and prices are recorded under the snapshot.
+func (s *Store) Import(ctx context.Context, dbInstanceList []*store.DBInstance, ts int64, source string) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO snapshot (created_ts, source) VALUES (?, ?)`, ts, source)
+	if err != nil {
+		return 0, fmt.Errorf("Fail to create the snapshot, [internal]: %v", err)
+	}
+	snapshotID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	// regionIDMap caches the IDs of the regions shared by the instances, keyed by the provider and the code.
+	regionIDMap := make(map[string]int64)
+	for _, dbInstance := range dbInstanceList {
+		externalID := dbInstance.GetExternalID()
+		dbInstanceID := store.GetID(externalID)
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO db_instance (id, external_id, cloud_provider, name)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT (external_id) DO NOTHING`,
+			dbInstanceID, externalID, dbInstance.CloudProvider, dbInstance.Name,
+		); err != nil {
+			return 0, fmt.Errorf("Fail to upsert the instance %s, [internal]: %v", externalID, err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO db_instance_snapshot (snapshot_id, db_instance_id, row_status, creator_id, updater_id, updated_ts, archived_ts, cpu, memory, processor, family, series, size, size_rank, architecture)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			snapshotID, dbInstanceID, getRowStatus(dbInstance.RowStatus), dbInstance.CreatorID, dbInstance.UpdaterID, dbInstance.UpdatedTs, dbInstance.ArchivedTs,
+			dbInstance.CPU, dbInstance.Memory, dbInstance.Processor,
+			dbInstance.Family, dbInstance.Series, dbInstance.Size, dbInstance.SizeRank, dbInstance.Architecture,
+		); err != nil {
+			return 0, fmt.Errorf("Fail to insert the state of the instance %s, [internal]: %v", externalID, err)
+		}
+
+		for _, r := range dbInstance.RegionList {
+			regionKey := fmt.Sprintf("%s:%s", dbInstance.CloudProvider, r.Code)
+			regionID, ok := regionIDMap[regionKey]
+			if !ok {
+				if err := tx.QueryRowContext(ctx, `
+					INSERT INTO region (cloud_provider, code)
+					VALUES (?, ?)
+					ON CONFLICT (cloud_provider, code) DO UPDATE SET code = excluded.code
+					RETURNING id`,
+					dbInstance.CloudProvider, r.Code,
+				).Scan(&regionID); err != nil {
+					return 0, fmt.Errorf("Fail to upsert the region %s, [internal]: %v", regionKey, err)
+				}
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO region_snapshot (snapshot_id, region_id, slug, name, geography, continent, latitude, longitude)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+					snapshotID, regionID, r.Slug, r.Name, r.Geography, r.Continent, r.Latitude, r.Longitude,
+				); err != nil {
+					return 0, fmt.Errorf("Fail to insert the state of the region %s, [internal]: %v", regionKey, err)
+				}
+				regionIDMap[regionKey] = regionID
+			}
+
+			var dbInstanceRegionID int64
+			if err := tx.QueryRowContext(ctx, `
+				INSERT INTO db_instance_region (db_instance_id, region_id)
+				VALUES (?, ?)
+				ON CONFLICT (db_instance_id, region_id) DO UPDATE SET region_id = excluded.region_id
+				RETURNING id`,
+				dbInstanceID, regionID,
+			).Scan(&dbInstanceRegionID); err != nil {
+				return 0, fmt.Errorf("Fail to upsert the region %s of %s, [internal]: %v", r.Code, externalID, err)
+			}
+
+			for _, term := range r.TermList {
+				var leaseContractLength, purchaseOption string
+				if term.Payload != nil {
+					leaseContractLength, purchaseOption = term.Payload.LeaseContractLength, term.Payload.PurchaseOption
+				}
+				var termID int64
+				if err := tx.QueryRowContext(ctx, `
+					INSERT INTO term (db_instance_region_id, code)
+					VALUES (?, ?)
+					ON CONFLICT (db_instance_region_id, code) DO UPDATE SET code = excluded.code
+					RETURNING id`,
+					dbInstanceRegionID, term.Code,
+				).Scan(&termID); err != nil {
+					return 0, fmt.Errorf("Fail to upsert the term %s, [internal]: %v", term.Code, err)
+				}
+
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO term_snapshot (snapshot_id, term_id, row_status, updated_ts, archived_ts, database_engine, type, lease_contract_length, purchase_option, hourly_usd, commitment_usd)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					snapshotID, termID, getRowStatus(term.RowStatus), term.UpdatedTs, term.ArchivedTs, term.DatabaseEngine, term.Type, leaseContractLength, purchaseOption,
+					term.HourlyUSD, term.CommitmentUSD,
+				); err != nil {
+					return 0, fmt.Errorf("Fail to insert the state of the term %s, [internal]: %v", term.Code, err)
+				}
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return snapshotID, nil
+}
+
+// ImportFile imports the local .json file saved by store.Save as a new snapshot created at ts.
+func (s *Store) ImportFile(ctx context.Context, filePath string, ts int64) (int64, error) {
+	dbInstanceList, err := store.Load(filePath)
+	if err != nil {
+		return 0, err
+	}
+	return s.Import(ctx, dbInstanceList, ts, filePath)
+}
+
+// Snapshot is a dataset imported at a given time.
+type Snapshot struct {
+	ID        int64
+	CreatedTs int64
+	Source    string
+}
+
+// ListSnapshot returns all the snapshots ordered by their ID.
+func (s *Store) ListSnapshot(ctx context.Context) ([]*Snapshot, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, created_ts, source FROM snapshot ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshotList []*Snapshot
+	for rows.Next() {
+		snapshot := &Snapshot{}
+		if err := rows.Scan(&snapshot.ID, &snapshot.CreatedTs, &snapshot.Source); err != nil {
+			return nil, err
+		}
+		snapshotList = append(snapshotList, snapshot)
+	}
+	return snapshotList, rows.Err()
+}
+
+// LoadSnapshot loads the dbInstanceList of the given snapshot with the state it was imported with, only the terms priced in that snapshot are included.
+// The output is sorted in the same way as store.Sort.
+func (s *Store) LoadSnapshot(ctx context.Context, snapshotID int64) ([]*store.DBInstance, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			db_instance.id, db_instance.external_id, db_instance_snapshot.row_status, db_instance_snapshot.creator_id, db_instance_snapshot.updater_id,
+			db_instance_snapshot.updated_ts, db_instance_snapshot.archived_ts,
+			db_instance.cloud_provider, db_instance.name, db_instance_snapshot.cpu, db_instance_snapshot.memory, db_instance_snapshot.processor,
+			db_instance_snapshot.family, db_instance_snapshot.series, db_instance_snapshot.size, db_instance_snapshot.size_rank, db_instance_snapshot.architecture,
+			region.code, region_snapshot.slug, region_snapshot.name, region_snapshot.geography, region_snapshot.continent, region_snapshot.latitude, region_snapshot.longitude,
+			term.code, term_snapshot.row_status, term_snapshot.updated_ts, term_snapshot.archived_ts, term_snapshot.database_engine, term_snapshot.type,
+			term_snapshot.lease_contract_length, term_snapshot.purchase_option,
+			term_snapshot.hourly_usd, term_snapshot.commitment_usd
+		FROM term_snapshot
+			JOIN term ON term.id = term_snapshot.term_id
+			JOIN db_instance_region ON db_instance_region.id = term.db_instance_region_id
+			JOIN db_instance ON db_instance.id = db_instance_region.db_instance_id
+			JOIN region ON region.id = db_instance_region.region_id
+			JOIN db_instance_snapshot ON db_instance_snapshot.snapshot_id = term_snapshot.snapshot_id AND db_instance_snapshot.db_instance_id = db_instance.id
+			JOIN region_snapshot ON region_snapshot.snapshot_id = term_snapshot.snapshot_id AND region_snapshot.region_id = region.id
+		WHERE term_snapshot.snapshot_id = ?
+		ORDER BY db_instance.external_id, region.code, term.code`,
+		snapshotID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var dbInstanceList []*store.DBInstance
+	var dbInstance *store.DBInstance
+	var r *store.Region
+	for rows.Next() {
+		row := &store.DBInstance{}
+		rowRegion := &store.Region{}
+		term := &store.Term{}
+		var leaseContractLength, purchaseOption string
+		if err := rows.Scan(
+			&row.ID, &row.ExternalID, &row.RowStatus, &row.CreatorID, &row.UpdaterID,
+			&row.UpdatedTs, &row.ArchivedTs,
+			&row.CloudProvider, &row.Name, &row.CPU, &row.Memory, &row.Processor,
+			&row.Family, &row.Series, &row.Size, &row.SizeRank, &row.Architecture,
+			&rowRegion.Code, &rowRegion.Slug, &rowRegion.Name, &rowRegion.Geography, &rowRegion.Continent, &rowRegion.Latitude, &rowRegion.Longitude,
+			&term.Code, &term.RowStatus, &term.UpdatedTs, &term.ArchivedTs, &term.DatabaseEngine, &term.Type, &leaseContractLength, &purchaseOption,
+			&term.HourlyUSD, &term.CommitmentUSD,
+		); err != nil {
+			return nil, err
+		}
+		if term.Type == client.ChargeTypeReserved {
+			term.Payload = &store.TermPayload{LeaseContractLength: leaseContractLength, PurchaseOption: purchaseOption}
+		}
+
+		if dbInstance == nil || dbInstance.ExternalID != row.ExternalID {
+			dbInstance = row
+			dbInstanceList = append(dbInstanceList, dbInstance)
+			r = nil
+		}
+		if r == nil || r.Code != rowRegion.Code {
+			r = rowRegion
+			dbInstance.RegionList = append(dbInstance.RegionList, r)
+		}
+		r.TermList = append(r.TermList, term)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// the price-per-unit metrics and the equivalents are derived from the snapshot, so they are not stored.
+	store.FillUnitPrice(dbInstanceList)
+	store.MatchEquivalent(dbInstanceList)
+	return dbInstanceList, nil
+}
+
+// LoadLatest loads the dbInstanceList of the latest snapshot, nil is returned if there is no snapshot.
+func (s *Store) LoadLatest(ctx context.Context) ([]*store.DBInstance, error) {
+	var snapshotID sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, `SELECT MAX(id) FROM snapshot`).Scan(&snapshotID); err != nil {
+		return nil, err
+	}
+	if !snapshotID.Valid {
+		return nil, nil
+	}
+	return s.LoadSnapshot(ctx, snapshotID.Int64)
+}
+
+// getRowStatus returns the row status, datasets generated before the row status was introduced fall back to NORMAL.
+func getRowStatus(rowStatus store.RowStatus) store.RowStatus {
+	if rowStatus == "" {
+		return store.RowStatusNormal
+	}
+	return rowStatus
+}
@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/bytebase/dbcost/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Import(t *testing.T) {
+	ctx := context.Background()
+	filePath := path.Join(t.TempDir(), "dbcost.db")
+	s, err := Open(ctx, filePath)
+	require.NoError(t, err)
+	defer s.Close()
+
+	version, err := s.Version(ctx)
+	require.NoError(t, err)
+	migrationList, err := getMigrationList()
+	require.NoError(t, err)
+	require.Equal(t, migrationList[len(migrationList)-1].version, version)
+
+	dbInstanceList, err := store.Load("../../data/sample.json")
+	require.NoError(t, err)
+	store.Sort(dbInstanceList)
+
+	snapshotID, err := s.ImportFile(ctx, "../../data/sample.json", 100)
+	require.NoError(t, err)
+	loadedList, err := s.LoadSnapshot(ctx, snapshotID)
+	require.NoError(t, err)
+	require.Len(t, loadedList, len(dbInstanceList))
+	for i, dbInstance := range loadedList {
+		require.Equal(t, dbInstanceList[i].ExternalID, dbInstance.ExternalID)
+		require.Equal(t, dbInstanceList[i].RegionList, dbInstance.RegionList)
+		require.Equal(t, dbInstanceList[i].Architecture, dbInstance.Architecture)
+		require.Equal(t, dbInstanceList[i].EquivalentList, dbInstance.EquivalentList)
+	}
+
+	// a price change is recorded in a new snapshot while the old one is kept.
+	dbInstanceList[0].RegionList[0].TermList[0].HourlyUSD = 9.9
+	_, err = s.Import(ctx, dbInstanceList, 200, "test")
+	require.NoError(t, err)
+	snapshotList, err := s.ListSnapshot(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshotList, 2)
+
+	var hourlyUSD float64
+	term := dbInstanceList[0].RegionList[0].TermList[0]
+	err = s.DB().QueryRowContext(ctx, `SELECT hourly_usd FROM latest_price WHERE term_code = ?`, term.Code).Scan(&hourlyUSD)
+	require.NoError(t, err)
+	require.Equal(t, 9.9, hourlyUSD)
+
+	latestList, err := s.LoadLatest(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 9.9, latestList[0].RegionList[0].TermList[0].HourlyUSD)
+	loadedList, err = s.LoadSnapshot(ctx, snapshotID)
+	require.NoError(t, err)
+	require.NotEqual(t, 9.9, loadedList[0].RegionList[0].TermList[0].HourlyUSD)
+
+	// reopening the database should not apply the migrations again.
+	require.NoError(t, s.Migrate(ctx))
+}
+
+func Test_LoadSnapshotHistory(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(ctx, path.Join(t.TempDir(), "dbcost.db"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	firstList, err := store.Load("../../data/sample.json")
+	require.NoError(t, err)
+	store.Sort(firstList)
+	// the ID is derived from the external ID on import.
+	for _, dbInstance := range firstList {
+		dbInstance.ID = store.GetID(dbInstance.GetExternalID())
+	}
+	firstID, err := s.Import(ctx, firstList, 100, "first")
+	require.NoError(t, err)
+
+	// the second dataset changes the spec, the lifecycle and the region metadata.
+	secondList, err := store.Load("../../data/sample.json")
+	require.NoError(t, err)
+	store.Sort(secondList)
+	secondList[0].CPU = 64
+	secondList[0].RegionList[0].Name = "Renamed"
+	secondList[0].RegionList[0].TermList[0].RowStatus = store.RowStatusArchived
+	secondList[0].RegionList[0].TermList[0].ArchivedTs = 200
+	secondList[1].RowStatus = store.RowStatusArchived
+	secondList[1].ArchivedTs = 200
+	secondID, err := s.Import(ctx, secondList, 200, "second")
+	require.NoError(t, err)
+
+	// the first snapshot round-trips unchanged.
+	loadedList, err := s.LoadSnapshot(ctx, firstID)
+	require.NoError(t, err)
+	require.Equal(t, firstList, loadedList)
+
+	loadedList, err = s.LoadSnapshot(ctx, secondID)
+	require.NoError(t, err)
+	require.Equal(t, 64, loadedList[0].CPU)
+	require.Equal(t, "Renamed", loadedList[0].RegionList[0].Name)
+	require.True(t, loadedList[0].RegionList[0].TermList[0].IsArchived())
+	require.True(t, loadedList[1].IsArchived())
+	require.Empty(t, loadedList[1].EquivalentList)
+}
+
+func Test_MigrateLegacy(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(ctx, path.Join(t.TempDir(), "dbcost.db"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	// rebuild the database at version 3, where the instances, the regions and the terms only kept the latest state.
+	for _, statement := range []string{
+		`DROP VIEW latest_price`,
+		`DROP TABLE term_snapshot`, `DROP TABLE term`, `DROP TABLE db_instance_region`, `DROP TABLE region_snapshot`,
+		`DROP TABLE region`, `DROP TABLE db_instance_snapshot`, `DROP TABLE db_instance`, `DROP TABLE snapshot`,
+		`DELETE FROM migration_history`,
+	} {
+		_, err := s.DB().ExecContext(ctx, statement)
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.migrate(ctx, 3))
+	for _, statement := range []string{
+		`INSERT INTO snapshot (id, created_ts, source) VALUES (1, 100, 'first'), (2, 200, 'second')`,
+		`INSERT INTO db_instance (id, external_id, row_status, cloud_provider, name, cpu, memory, family, architecture) VALUES (1, 'AWS:db.r6g.large', 'NORMAL', 'AWS', 'db.r6g.large', 2, '16', 'MEMORY_OPTIMIZED', 'ARM64')`,
+		`INSERT INTO region (id, db_instance_id, code, slug) VALUES (1, 1, 'us-east-1', 'us-east-virginia')`,
+		`INSERT INTO term (id, region_id, code, database_engine, type) VALUES (1, 1, 'AAA.a', 'MYSQL', 'OnDemand')`,
+		`INSERT INTO term_price (snapshot_id, term_id, hourly_usd, commitment_usd) VALUES (1, 1, 0.2, 0), (2, 1, 0.3, 0)`,
+	} {
+		_, err := s.DB().ExecContext(ctx, statement)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, s.Migrate(ctx))
+	for i, hourlyUSD := range []float64{0.2, 0.3} {
+		loadedList, err := s.LoadSnapshot(ctx, int64(i+1))
+		require.NoError(t, err)
+		require.Len(t, loadedList, 1)
+		require.Equal(t, 2, loadedList[0].CPU)
+		require.Equal(t, "ARM64", string(loadedList[0].Architecture))
+		require.Equal(t, "us-east-virginia", loadedList[0].RegionList[0].Slug)
+		require.Equal(t, hourlyUSD, loadedList[0].RegionList[0].TermList[0].HourlyUSD)
+	}
+}
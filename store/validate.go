@@ -0,0 +1,233 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidationError is a violation of the dataset schema.
+type ValidationError struct {
+	// Path is the JSON path of the violating value, e.g. $.dbInstanceList[0].regionList[1].code
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidateDataset validates the dataset file content against the schema generated by GenerateSchema.
+// Besides the schema, the external IDs of the instances should be unique.
+// An error is returned only if the content is not a valid JSON.
+func ValidateDataset(dataByted []byte) ([]*ValidationError, error) {
+	schemaByted, err := MarshalSchema()
+	if err != nil {
+		return nil, err
+	}
+	return ValidateDatasetWithSchema(dataByted, schemaByted)
+}
+
+// ValidateDatasetWithSchema validates the dataset file content against a published schema,
+// e.g. schema/dataset.v1.json for the data declaring an older schema version.
+func ValidateDatasetWithSchema(dataByted []byte, schemaByted []byte) ([]*ValidationError, error) {
+	var value interface{}
+	if err := json.Unmarshal(dataByted, &value); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the dataset, [internal]: %v", err)
+	}
+	// round trip the schema so that it is of the same types as the decoded value.
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaByted, &schema); err != nil {
+		return nil, fmt.Errorf("Fail to unmarshal the schema, [internal]: %v", err)
+	}
+
+	v := &validator{defs: schema["$defs"].(map[string]interface{})}
+	v.validate(schema, value, "$")
+	if len(v.errorList) == 0 {
+		v.validateUniqueExternalID(value)
+	}
+	return v.errorList, nil
+}
+
+// GetSchemaVersion returns the schema version the dataset file content declares, 0 if not declared.
+func GetSchemaVersion(dataByted []byte) (int, error) {
+	dataset := struct {
+		SchemaVersion int `json:"schemaVersion"`
+	}{}
+	if err := json.Unmarshal(dataByted, &dataset); err != nil {
+		return 0, fmt.Errorf("Fail to unmarshal the dataset, [internal]: %v", err)
+	}
+	return dataset.SchemaVersion, nil
+}
+
+type validator struct {
+	defs      map[string]interface{}
+	errorList []*ValidationError
+}
+
+func (v *validator) addError(path, format string, a ...interface{}) {
+	v.errorList = append(v.errorList, &ValidationError{Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+// validate validates the value against the subset of JSON Schema generated by GenerateSchema.
+func (v *validator) validate(schema map[string]interface{}, value interface{}, path string) {
+	if ref, ok := schema["$ref"].(string); ok {
+		v.validate(v.defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{}), value, path)
+		return
+	}
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		v.validateAnyOf(anyOf, value, path)
+		return
+	}
+	if expected, ok := schema["const"]; ok && !reflect.DeepEqual(expected, value) {
+		v.addError(path, "should be %v", expected)
+		return
+	}
+	if typ, ok := schema["type"]; ok && !matchType(typ, value) {
+		v.addError(path, "should be of type %v, got %s", typ, getType(value))
+		return
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && !containValue(enum, value) {
+		v.addError(path, "%v is not one of %v", value, enum)
+	}
+
+	if condition, ok := schema["if"].(map[string]interface{}); ok {
+		sub := &validator{defs: v.defs}
+		sub.validate(condition, value, path)
+		if then, ok := schema["then"].(map[string]interface{}); ok && len(sub.errorList) == 0 {
+			v.validate(then, value, path)
+		}
+	}
+
+	switch val := value.(type) {
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && val < minimum {
+			v.addError(path, "%v should be no less than %v", val, minimum)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && val > maximum {
+			v.addError(path, "%v should be no greater than %v", val, maximum)
+		}
+	case string:
+		if minLength, ok := schema["minLength"].(float64); ok && float64(len(val)) < minLength {
+			v.addError(path, "should not be empty")
+		}
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(val) {
+			v.addError(path, "%q should match %s", val, pattern)
+		}
+	case []interface{}:
+		if minItems, ok := schema["minItems"].(float64); ok && float64(len(val)) < minItems {
+			v.addError(path, "should have at least %v item", minItems)
+		}
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range val {
+				v.validate(items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case map[string]interface{}:
+		v.validateObject(schema, val, path)
+	}
+}
+
+func (v *validator) validateObject(schema map[string]interface{}, value map[string]interface{}, path string) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if _, ok := value[name.(string)]; !ok {
+				v.addError(path, "missing required property %q", name)
+			}
+		}
+	}
+
+	var keyList []string
+	for key := range value {
+		keyList = append(keyList, key)
+	}
+	sort.Strings(keyList)
+	for _, key := range keyList {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				v.addError(path, "unknown property %q", key)
+			}
+			continue
+		}
+		v.validate(property, value[key], path+"."+key)
+	}
+}
+
+// validateAnyOf validates the value against the alternatives.
+// If none matches, the errors of the first alternative not only matching null are reported, as the generated schema only uses anyOf for nullable values.
+func (v *validator) validateAnyOf(anyOf []interface{}, value interface{}, path string) {
+	var reportedErrorList []*ValidationError
+	for _, alternative := range anyOf {
+		sub := &validator{defs: v.defs}
+		sub.validate(alternative.(map[string]interface{}), value, path)
+		if len(sub.errorList) == 0 {
+			return
+		}
+		if reportedErrorList == nil && alternative.(map[string]interface{})["type"] != "null" {
+			reportedErrorList = sub.errorList
+		}
+	}
+	v.errorList = append(v.errorList, reportedErrorList...)
+}
+
+func (v *validator) validateUniqueExternalID(value interface{}) {
+	dbInstanceList, _ := value.(map[string]interface{})["dbInstanceList"].([]interface{})
+	indexMap := make(map[string]int)
+	for i, dbInstance := range dbInstanceList {
+		externalID := dbInstance.(map[string]interface{})["externalId"].(string)
+		if previous, ok := indexMap[externalID]; ok {
+			v.addError(fmt.Sprintf("$.dbInstanceList[%d].externalId", i), "%s is duplicated with $.dbInstanceList[%d]", externalID, previous)
+			continue
+		}
+		indexMap[externalID] = i
+	}
+}
+
+func matchType(typ interface{}, value interface{}) bool {
+	if typeList, ok := typ.([]interface{}); ok {
+		for _, t := range typeList {
+			if matchType(t, value) {
+				return true
+			}
+		}
+		return false
+	}
+	actual := getType(value)
+	return actual == typ || (typ == "number" && actual == "integer")
+}
+
+func getType(value interface{}) string {
+	switch val := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if val == math.Trunc(val) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+func containValue(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(e, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+// Package taxonomy classifies the database instances of all cloud providers into normalized families and sizes.
+package taxonomy
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Family is the normalized family of an instance.
+type Family string
+
+const (
+	// FamilyGeneralPurpose is the family for instances with balanced CPU and memory.
+	FamilyGeneralPurpose Family = "GENERAL_PURPOSE"
+	// FamilyMemoryOptimized is the family for instances with a high memory to CPU ratio.
+	FamilyMemoryOptimized Family = "MEMORY_OPTIMIZED"
+	// FamilyBurstable is the family for instances with a baseline CPU performance that can burst.
+	FamilyBurstable Family = "BURSTABLE"
+	// FamilyComputeOptimized is the family for instances with a high CPU to memory ratio.
+	FamilyComputeOptimized Family = "COMPUTE_OPTIMIZED"
+)
+
+// Architecture is the CPU architecture of an instance.
+type Architecture string
+
+const (
+	// ArchitectureX86 is the architecture for Intel and AMD processors.
+	ArchitectureX86 Architecture = "X86_64"
+	// ArchitectureARM is the architecture for ARM processors, e.g. AWS Graviton.
+	ArchitectureARM Architecture = "ARM64"
+)
+
+// Class is the classification of an instance.
+type Class struct {
+	Family Family
+	// Series is the provider specific family of the instance, e.g. r6g for AWS, N1Standard for GCP.
+	Series string
+	// Size is the provider specific size of the instance, e.g. xlarge for AWS, 96-360 for GCP.
+	Size string
+	// SizeRank is proportional to the capacity of the instance and is comparable across providers.
+	// It follows the normalization factor used by AWS, where a large instance is 16 and a xlarge instance is 32.
+	SizeRank     int
+	Architecture Architecture
+}
+
+// sizeRankPerCPU is the size rank of a single vCPU, as an AWS xlarge instance usually has 4 vCPUs.
+const sizeRankPerCPU = 8
+
+// awsSizeRankMap is the size rank of the AWS sizes that are not multiples of xlarge.
+var awsSizeRankMap = map[string]int{
+	"nano":   1,
+	"micro":  2,
+	"small":  4,
+	"medium": 8,
+	"large":  16,
+	"xlarge": 32,
+}
+
+// Classify classifies the instance of the given provider by its name, e.g. db.r6g.xlarge, db-N1Standard-96-360.
+// The cpu is used when the size can not be derived from the name.
+func Classify(provider, name string, cpu int) *Class {
+	switch provider {
+	case "AWS":
+		return classifyAWS(name, cpu)
+	case "GCP":
+		return classifyGCP(name, cpu)
+	}
+	return &Class{
+		Family:       FamilyGeneralPurpose,
+		SizeRank:     cpu * sizeRankPerCPU,
+		Architecture: ArchitectureX86,
+	}
+}
+
+// classifyAWS classifies the instance in the form of db.${SERIES}.${SIZE}, e.g. db.r6g.xlarge.
+func classifyAWS(name string, cpu int) *Class {
+	class := &Class{
+		Family:       FamilyGeneralPurpose,
+		SizeRank:     cpu * sizeRankPerCPU,
+		Architecture: ArchitectureX86,
+	}
+	part := strings.Split(name, ".")
+	if len(part) != 3 {
+		return class
+	}
+	class.Series, class.Size = part[1], part[2]
+
+	// The first letter of the series is the instance class, e.g. r for memory optimized.
+	switch class.Series[0] {
+	case 't':
+		class.Family = FamilyBurstable
+	case 'r', 'x', 'z':
+		class.Family = FamilyMemoryOptimized
+	case 'c':
+		class.Family = FamilyComputeOptimized
+	}
+	// The g in the attributes after the generation is for Graviton, e.g. r6g, c6gd.
+	if generation := strings.IndexAny(class.Series, "0123456789"); generation >= 0 && strings.Contains(class.Series[generation:], "g") {
+		class.Architecture = ArchitectureARM
+	}
+
+	if rank, ok := awsSizeRankMap[class.Size]; ok {
+		class.SizeRank = rank
+	} else if multiplier, err := strconv.Atoi(strings.TrimSuffix(class.Size, "xlarge")); err == nil {
+		// e.g. 4xlarge
+		class.SizeRank = multiplier * awsSizeRankMap["xlarge"]
+	}
+	return class
+}
+
+// classifyGCP classifies the instance in the form of db-${SERIES}-${CPU}-${MEMORY}, e.g. db-N1Standard-96-360.
+// The name is synthesized by the GCP client from the resource group of the offer.
+func classifyGCP(name string, cpu int) *Class {
+	class := &Class{
+		Family:       FamilyGeneralPurpose,
+		SizeRank:     cpu * sizeRankPerCPU,
+		Architecture: ArchitectureX86,
+	}
+	part := strings.SplitN(name, "-", 3)
+	if len(part) != 3 {
+		return class
+	}
+	class.Series, class.Size = part[1], part[2]
+
+	series := strings.ToLower(class.Series)
+	switch {
+	case strings.Contains(series, "highmem"):
+		class.Family = FamilyMemoryOptimized
+	case strings.Contains(series, "highcpu"):
+		class.Family = FamilyComputeOptimized
+	case strings.Contains(series, "micro"), strings.Contains(series, "small"), strings.Contains(series, "shared"):
+		class.Family = FamilyBurstable
+	}
+	return class
+}
@@ -0,0 +1,30 @@
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Classify(t *testing.T) {
+	tests := []struct {
+		provider string
+		name     string
+		cpu      int
+		want     *Class
+	}{
+		{"AWS", "db.r6g.4xlarge", 16, &Class{FamilyMemoryOptimized, "r6g", "4xlarge", 128, ArchitectureARM}},
+		{"AWS", "db.m5.large", 2, &Class{FamilyGeneralPurpose, "m5", "large", 16, ArchitectureX86}},
+		{"AWS", "db.x2iedn.xlarge", 4, &Class{FamilyMemoryOptimized, "x2iedn", "xlarge", 32, ArchitectureX86}},
+		{"AWS", "db.t3.micro", 2, &Class{FamilyBurstable, "t3", "micro", 2, ArchitectureX86}},
+		{"AWS", "db.x2g.xlarge", 4, &Class{FamilyMemoryOptimized, "x2g", "xlarge", 32, ArchitectureARM}},
+		{"AWS", "db.c6gd.medium", 1, &Class{FamilyComputeOptimized, "c6gd", "medium", 8, ArchitectureARM}},
+		{"GCP", "db-N1Standard-96-360", 96, &Class{FamilyGeneralPurpose, "N1Standard", "96-360", 768, ArchitectureX86}},
+		{"GCP", "db-N1Highmem-4-26", 4, &Class{FamilyMemoryOptimized, "N1Highmem", "4-26", 32, ArchitectureX86}},
+		// unknown names fall back to the CPU.
+		{"AWS", "unknown", 2, &Class{FamilyGeneralPurpose, "", "", 16, ArchitectureX86}},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, Classify(test.provider, test.name, test.cpu), test.name)
+	}
+}